@@ -0,0 +1,103 @@
+package either
+
+import "github.com/tompaz3/fungo/maybe"
+
+// Either - holds either a Left or a Right value.
+// Nil *Either is neither Left nor Right, all the methods and functions are safe to use with it.
+type Either[L, R any] struct {
+	isRight bool
+	left    L
+	right   R
+}
+
+//nolint:unused // sealed interface
+func (e *Either[L, R]) sealedEither() {}
+
+func (e *Either[L, R]) IsLeft() bool {
+	return e != nil && !e.isRight
+}
+
+func (e *Either[L, R]) IsRight() bool {
+	return e != nil && e.isRight
+}
+
+// Left - returns Some(left) if Left, None otherwise.
+func (e *Either[L, R]) Left() *maybe.Maybe[L] {
+	if !e.IsLeft() {
+		return maybe.None[L]()
+	}
+
+	return maybe.Some(e.left)
+}
+
+// Right - returns Some(right) if Right, None otherwise.
+func (e *Either[L, R]) Right() *maybe.Maybe[R] {
+	if !e.IsRight() {
+		return maybe.None[R]()
+	}
+
+	return maybe.Some(e.right)
+}
+
+func (e *Either[L, R]) Swap() *Either[R, L] {
+	switch {
+	case e.IsLeft():
+		return Right[R](e.left)
+	case e.IsRight():
+		return Left[R, L](e.right)
+	default:
+		return nil
+	}
+}
+
+func Left[L, R any](value L) *Either[L, R] {
+	return &Either[L, R]{left: value}
+}
+
+func Right[L, R any](value R) *Either[L, R] {
+	return &Either[L, R]{isRight: true, right: value}
+}
+
+// FromMaybe - converts Some(v) into Right(v) and None into Left(left).
+func FromMaybe[L, R any](mb *maybe.Maybe[R], left L) *Either[L, R] {
+	if mb.IsEmpty() {
+		return Left[L, R](left)
+	}
+
+	return Right[L](mb.OrZero())
+}
+
+// Fold - applies onLeft or onRight function depending on the Either value, returns zero value for nil Either.
+func Fold[L, R, U any](e *Either[L, R], onLeft func(L) U, onRight func(R) U) U {
+	switch {
+	case e.IsLeft():
+		return onLeft(e.left)
+	case e.IsRight():
+		return onRight(e.right)
+	default:
+		var zero U
+		return zero
+	}
+}
+
+func MapLeft[L, R, U any](e *Either[L, R], fn func(L) U) *Either[U, R] {
+	switch {
+	case e.IsLeft():
+		return Left[U, R](fn(e.left))
+	case e.IsRight():
+		return Right[U](e.right)
+	default:
+		return nil
+	}
+}
+
+func MapRight[L, R, U any](e *Either[L, R], fn func(R) U) *Either[L, U] {
+	switch {
+	case e.IsLeft():
+		return Left[L, U](e.left)
+	case e.IsRight():
+		return Right[L](fn(e.right))
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,223 @@
+package either_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/either"
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_Either_IsLeft_IsRight(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`left`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.Left[string, int]("cached")
+
+		assert.True(t, res.IsLeft())
+		assert.False(t, res.IsRight())
+	})
+
+	t.Run(`right`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.Right[string](1)
+
+		assert.False(t, res.IsLeft())
+		assert.True(t, res.IsRight())
+	})
+
+	t.Run(`zero value`, func(t *testing.T) {
+		t.Parallel()
+
+		var res either.Either[string, int]
+
+		assert.True(t, res.IsLeft())
+		assert.False(t, res.IsRight())
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var res *either.Either[string, int]
+
+		assert.False(t, res.IsLeft())
+		assert.False(t, res.IsRight())
+		assert.True(t, res.Left().IsEmpty())
+		assert.True(t, res.Right().IsEmpty())
+		assert.Nil(t, res.Swap())
+	})
+}
+
+func Test_Either_Left_Right(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`left`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.Left[string, int]("cached")
+
+		assert.Equal(t, "cached", res.Left().OrZero())
+		assert.True(t, res.Right().IsEmpty())
+	})
+
+	t.Run(`right`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.Right[string](1)
+
+		assert.True(t, res.Left().IsEmpty())
+		assert.Equal(t, 1, res.Right().OrZero())
+	})
+}
+
+func Test_Either_Swap(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`left`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.Left[string, int]("cached").Swap()
+
+		assert.True(t, res.IsRight())
+		assert.Equal(t, "cached", res.Right().OrZero())
+	})
+
+	t.Run(`right`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.Right[string](1).Swap()
+
+		assert.True(t, res.IsLeft())
+		assert.Equal(t, 1, res.Left().OrZero())
+	})
+}
+
+func Test_Fold(t *testing.T) {
+	t.Parallel()
+
+	onLeft := func(s string) string { return "left: " + s }
+	onRight := func(i int) string { return "right: " + strconv.Itoa(i) }
+
+	t.Run(`left`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.Fold(either.Left[string, int]("cached"), onLeft, onRight)
+
+		assert.Equal(t, "left: cached", res)
+	})
+
+	t.Run(`right`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.Fold(either.Right[string](1), onLeft, onRight)
+
+		assert.Equal(t, "right: 1", res)
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var e *either.Either[string, int]
+
+		res := either.Fold(e, onLeft, onRight)
+
+		assert.Zero(t, res)
+	})
+}
+
+func Test_MapLeft(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`left`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.MapLeft(either.Left[string, int]("1"), func(s string) int { return len(s) })
+
+		assert.Equal(t, 1, res.Left().OrZero())
+	})
+
+	t.Run(`right`, func(t *testing.T) {
+		t.Parallel()
+
+		mapperEvaluated := false
+
+		res := either.MapLeft(either.Right[string](2), func(s string) int {
+			mapperEvaluated = true
+			return len(s)
+		})
+
+		assert.Equal(t, 2, res.Right().OrZero())
+		assert.False(t, mapperEvaluated)
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var e *either.Either[string, int]
+
+		res := either.MapLeft(e, func(s string) int { return len(s) })
+
+		assert.Nil(t, res)
+	})
+}
+
+func Test_MapRight(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`left`, func(t *testing.T) {
+		t.Parallel()
+
+		mapperEvaluated := false
+
+		res := either.MapRight(either.Left[string, int]("cached"), func(i int) string {
+			mapperEvaluated = true
+			return strconv.Itoa(i)
+		})
+
+		assert.Equal(t, "cached", res.Left().OrZero())
+		assert.False(t, mapperEvaluated)
+	})
+
+	t.Run(`right`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.MapRight(either.Right[string](2), strconv.Itoa)
+
+		assert.Equal(t, "2", res.Right().OrZero())
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var e *either.Either[string, int]
+
+		res := either.MapRight(e, strconv.Itoa)
+
+		assert.Nil(t, res)
+	})
+}
+
+func Test_FromMaybe(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.FromMaybe(maybe.Some(1), "missing")
+
+		assert.Equal(t, 1, res.Right().OrZero())
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := either.FromMaybe(maybe.None[int](), "missing")
+
+		assert.Equal(t, "missing", res.Left().OrZero())
+	})
+}
@@ -0,0 +1,43 @@
+package fungotest
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+var maybePkgPath = reflect.TypeFor[maybe.Maybe[int]]().PkgPath()
+
+// CmpOption - github.com/google/go-cmp option comparing maybe.Maybe[T] values (e.g. struct fields)
+// using the Maybe Equal method.
+// It is required for Maybe[T] values only, since go-cmp uses the Equal method for *Maybe[T] by itself,
+// but can't access the unexported fields of Maybe[T] values.
+func CmpOption() cmp.Option {
+	return cmp.FilterValues(
+		func(a, b any) bool {
+			return isMaybeValue(reflect.TypeOf(a)) && reflect.TypeOf(a) == reflect.TypeOf(b)
+		},
+		cmp.Comparer(func(a, b any) bool {
+			return equalMaybeValues(a, b)
+		}),
+	)
+}
+
+// isMaybeValue - tests if the type is maybe.Maybe[T] (not a pointer).
+func isMaybeValue(tp reflect.Type) bool {
+	return tp != nil && tp.Kind() == reflect.Struct &&
+		tp.PkgPath() == maybePkgPath && strings.HasPrefix(tp.Name(), "Maybe[")
+}
+
+// equalMaybeValues - compares maybe.Maybe[T] values a and b using (*Maybe[T]).Equal.
+func equalMaybeValues(a, b any) bool {
+	aPtr := reflect.New(reflect.TypeOf(a))
+	aPtr.Elem().Set(reflect.ValueOf(a))
+	bPtr := reflect.New(reflect.TypeOf(b))
+	bPtr.Elem().Set(reflect.ValueOf(b))
+
+	return aPtr.MethodByName("Equal").Call([]reflect.Value{bPtr})[0].Bool()
+}
@@ -0,0 +1,40 @@
+package fungotest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/fungotest"
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_CmpOption(t *testing.T) {
+	t.Parallel()
+
+	type valueRecord struct {
+		Age     maybe.Maybe[int]
+		Tags    maybe.Maybe[[]string]
+		Created maybe.Maybe[time.Time]
+		Nick    *maybe.Maybe[string]
+	}
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	a := valueRecord{Age: *maybe.Some(30), Tags: *maybe.None[[]string](), Created: *maybe.Some(created)}
+	b := valueRecord{
+		Age:     *maybe.Some(30),
+		Tags:    *maybe.Null[[]string](),
+		Created: *maybe.Some(created.In(time.FixedZone("CET", 3600))),
+		Nick:    maybe.None[string](),
+	}
+	c := valueRecord{Age: *maybe.Some(31), Tags: *maybe.Some([]string{"a"}), Created: *maybe.Some(created)}
+
+	assert.Panics(t, func() { cmp.Equal(a, b) })
+	assert.Empty(t, cmp.Diff(a, b, fungotest.CmpOption()))
+	assert.True(t, cmp.Equal(a, b, fungotest.CmpOption()))
+	assert.False(t, cmp.Equal(a, c, fungotest.CmpOption()))
+	assert.NotEmpty(t, cmp.Diff(a, c, fungotest.CmpOption()))
+}
@@ -0,0 +1,14 @@
+package fungotest_test
+
+import (
+	"testing"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+)
+
+func TestFungotest(t *testing.T) {
+	t.Parallel()
+	o.RegisterFailHandler(g.Fail)
+	g.RunSpecs(t, "Fungotest Suite")
+}
@@ -0,0 +1,140 @@
+package fungotest
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+
+	match "github.com/tompaz3/fungo/match/error"
+)
+
+// BeSome - succeeds if actual is a Maybe holding a value.
+func BeSome() types.GomegaMatcher {
+	return &beSomeMatcher{}
+}
+
+// BeNone - succeeds if actual is an empty Maybe.
+func BeNone() types.GomegaMatcher {
+	return &beNoneMatcher{}
+}
+
+// HaveSomeValue - succeeds if actual is a Maybe holding a value, which matches given matcher.
+// Values, which are not matchers, are compared using gomega.Equal.
+func HaveSomeValue(expected any) types.GomegaMatcher {
+	matcher, ok := expected.(types.GomegaMatcher)
+	if !ok {
+		matcher = gomega.Equal(expected)
+	}
+
+	return &haveSomeValueMatcher{matcher: matcher}
+}
+
+// MatchErrorOfType - succeeds if actual is an error of type E (checked using errors.As) matching given predicate.
+// Nil predicate matches any error of type E.
+func MatchErrorOfType[E error](pred match.ErrorPredicate[E]) types.GomegaMatcher {
+	return &matchErrorOfTypeMatcher[E]{pred: pred}
+}
+
+type beSomeMatcher struct{}
+
+func (m *beSomeMatcher) Match(actual any) (bool, error) {
+	_, defined, err := unwrapMaybe(actual)
+	return defined, err
+}
+
+func (m *beSomeMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("Expected Some, got %s", describeMaybe(actual))
+}
+
+func (m *beSomeMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected not Some, got %s", describeMaybe(actual))
+}
+
+type beNoneMatcher struct{}
+
+func (m *beNoneMatcher) Match(actual any) (bool, error) {
+	_, defined, err := unwrapMaybe(actual)
+	return !defined, err
+}
+
+func (m *beNoneMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("Expected None, got %s", describeMaybe(actual))
+}
+
+func (m *beNoneMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected not None, got %s", describeMaybe(actual))
+}
+
+type haveSomeValueMatcher struct {
+	matcher types.GomegaMatcher
+}
+
+func (m *haveSomeValueMatcher) Match(actual any) (bool, error) {
+	value, defined, err := unwrapMaybe(actual)
+	if err != nil || !defined {
+		return false, err
+	}
+
+	return m.matcher.Match(value)
+}
+
+func (m *haveSomeValueMatcher) FailureMessage(actual any) string {
+	value, defined, _ := unwrapMaybe(actual)
+	if !defined {
+		return fmt.Sprintf("Expected Some value, got %s", describeMaybe(actual))
+	}
+
+	return fmt.Sprintf("Expected Some value matching, got %s:\n%s",
+		describeMaybe(actual), m.matcher.FailureMessage(value))
+}
+
+func (m *haveSomeValueMatcher) NegatedFailureMessage(actual any) string {
+	value, _, _ := unwrapMaybe(actual)
+
+	return fmt.Sprintf("Expected not Some value matching, got %s:\n%s",
+		describeMaybe(actual), m.matcher.NegatedFailureMessage(value))
+}
+
+type matchErrorOfTypeMatcher[E error] struct {
+	pred match.ErrorPredicate[E]
+}
+
+func (m *matchErrorOfTypeMatcher[E]) Match(actual any) (bool, error) {
+	if actual == nil {
+		return false, nil
+	}
+	err, ok := actual.(error)
+	if !ok {
+		return false, fmt.Errorf("expected an error, got:\n%s", format.Object(actual, 1))
+	}
+
+	typedErr, ok := match.ErrorType[E](err)
+	if !ok {
+		return false, nil
+	}
+
+	return m.pred == nil || m.pred(typedErr), nil
+}
+
+func (m *matchErrorOfTypeMatcher[E]) FailureMessage(actual any) string {
+	return format.Message(actual, fmt.Sprintf("to be an error of type %s%s", m.typeName(), m.predDesc()))
+}
+
+func (m *matchErrorOfTypeMatcher[E]) NegatedFailureMessage(actual any) string {
+	return format.Message(actual, fmt.Sprintf("not to be an error of type %s%s", m.typeName(), m.predDesc()))
+}
+
+func (m *matchErrorOfTypeMatcher[E]) typeName() string {
+	return reflect.TypeFor[E]().String()
+}
+
+func (m *matchErrorOfTypeMatcher[E]) predDesc() string {
+	if m.pred == nil {
+		return ""
+	}
+
+	return " matching the predicate"
+}
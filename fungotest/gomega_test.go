@@ -0,0 +1,151 @@
+package fungotest_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+
+	"github.com/tompaz3/fungo/fungotest"
+	"github.com/tompaz3/fungo/maybe"
+)
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+var _ = g.Describe("BeSome", func() {
+	g.When("Maybe holds a value", func() {
+		g.It("should succeed", func() {
+			o.Expect(maybe.Some(1)).To(fungotest.BeSome())
+			o.Expect(maybe.SomeOpt(1)).To(fungotest.BeSome())
+			o.Expect(maybe.Lazy(func() *maybe.Maybe[int] { return maybe.Some(1) })).To(fungotest.BeSome())
+		})
+	})
+
+	g.When("Maybe is empty", func() {
+		g.It("should fail and describe the Maybe", func() {
+			matcher := fungotest.BeSome()
+
+			ok, err := matcher.Match(maybe.None[int]())
+
+			o.Expect(err).NotTo(o.HaveOccurred())
+			o.Expect(ok).To(o.BeFalse())
+			o.Expect(matcher.FailureMessage(maybe.None[int]())).To(o.Equal("Expected Some, got None"))
+		})
+	})
+
+	g.When("actual is not a Maybe", func() {
+		g.It("should return an error", func() {
+			_, err := fungotest.BeSome().Match(1)
+
+			o.Expect(err).To(o.HaveOccurred())
+		})
+	})
+})
+
+var _ = g.Describe("BeNone", func() {
+	g.When("Maybe is empty", func() {
+		g.It("should succeed", func() {
+			o.Expect(maybe.None[int]()).To(fungotest.BeNone())
+			o.Expect(maybe.NoneOpt[int]()).To(fungotest.BeNone())
+
+			var nilMaybe *maybe.Maybe[int]
+			o.Expect(nilMaybe).To(fungotest.BeNone())
+		})
+	})
+
+	g.When("Maybe holds a value", func() {
+		g.It("should fail and show the value", func() {
+			matcher := fungotest.BeNone()
+
+			ok, err := matcher.Match(maybe.Some("john"))
+
+			o.Expect(err).NotTo(o.HaveOccurred())
+			o.Expect(ok).To(o.BeFalse())
+			o.Expect(matcher.FailureMessage(maybe.Some("john"))).To(o.Equal(`Expected None, got Some("john")`))
+		})
+	})
+})
+
+var _ = g.Describe("HaveSomeValue", func() {
+	g.When("Maybe holds a matching value", func() {
+		g.It("should succeed", func() {
+			o.Expect(maybe.Some(1)).To(fungotest.HaveSomeValue(1))
+			o.Expect(maybe.Some(5)).To(fungotest.HaveSomeValue(o.BeNumerically(">", 3)))
+			o.Expect(maybe.Some(5)).NotTo(fungotest.HaveSomeValue(4))
+		})
+	})
+
+	g.When("Maybe holds a value not matching", func() {
+		g.It("should fail and show the value", func() {
+			matcher := fungotest.HaveSomeValue(2)
+
+			ok, err := matcher.Match(maybe.Some(1))
+
+			o.Expect(err).NotTo(o.HaveOccurred())
+			o.Expect(ok).To(o.BeFalse())
+			o.Expect(matcher.FailureMessage(maybe.Some(1))).To(o.HavePrefix("Expected Some value matching, got Some(1)"))
+		})
+	})
+
+	g.When("Maybe is empty", func() {
+		g.It("should fail", func() {
+			matcher := fungotest.HaveSomeValue(1)
+
+			ok, err := matcher.Match(maybe.None[int]())
+
+			o.Expect(err).NotTo(o.HaveOccurred())
+			o.Expect(ok).To(o.BeFalse())
+			o.Expect(matcher.FailureMessage(maybe.None[int]())).To(o.Equal("Expected Some value, got None"))
+		})
+	})
+})
+
+var _ = g.Describe("MatchErrorOfType", func() {
+	g.When("error is of the expected type and matches the predicate", func() {
+		g.It("should succeed", func() {
+			err := fmt.Errorf("wrapped: %w", &codeError{code: 404})
+
+			o.Expect(err).To(fungotest.MatchErrorOfType[*codeError](nil))
+			o.Expect(err).To(fungotest.MatchErrorOfType(func(err *codeError) bool {
+				return err.code == 404
+			}))
+		})
+	})
+
+	g.When("error doesn't match the predicate", func() {
+		g.It("should fail", func() {
+			err := &codeError{code: 500}
+			matcher := fungotest.MatchErrorOfType(func(err *codeError) bool { return err.code == 404 })
+
+			ok, matchErr := matcher.Match(err)
+
+			o.Expect(matchErr).NotTo(o.HaveOccurred())
+			o.Expect(ok).To(o.BeFalse())
+			o.Expect(matcher.FailureMessage(err)).To(o.ContainSubstring("to be an error of type *fungotest_test.codeError matching the predicate"))
+		})
+	})
+
+	g.When("error is of a different type", func() {
+		g.It("should fail", func() {
+			o.Expect(io.EOF).NotTo(fungotest.MatchErrorOfType[*codeError](nil))
+			o.Expect(nil).NotTo(fungotest.MatchErrorOfType[*codeError](nil))
+		})
+	})
+
+	g.When("actual is not an error", func() {
+		g.It("should return an error", func() {
+			_, err := fungotest.MatchErrorOfType[*codeError](nil).Match("boom")
+
+			o.Expect(err).To(o.HaveOccurred())
+			o.Expect(errors.Is(err, io.EOF)).To(o.BeFalse())
+		})
+	})
+})
@@ -0,0 +1,45 @@
+package fungotest
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/onsi/gomega/format"
+)
+
+// definable - implemented by maybe.Maybe, maybe.Opt and maybe.LazyMaybe.
+type definable interface {
+	IsDefined() bool
+}
+
+// unwrapMaybe - returns the value held by actual (any of maybe.Maybe, maybe.Opt or maybe.LazyMaybe)
+// and whether the value is present.
+func unwrapMaybe(actual any) (any, bool, error) {
+	mb, ok := actual.(definable)
+	if !ok {
+		return nil, false, fmt.Errorf("expected a Maybe, got:\n%s", format.Object(actual, 1))
+	}
+	if !mb.IsDefined() {
+		return nil, false, nil
+	}
+
+	get := reflect.ValueOf(actual).MethodByName("Get")
+	if !get.IsValid() {
+		return nil, false, fmt.Errorf("expected a Maybe with Get method, got:\n%s", format.Object(actual, 1))
+	}
+
+	return get.Call(nil)[0].Interface(), true, nil
+}
+
+// describeMaybe - describes the content of actual as Some(value) or None.
+func describeMaybe(actual any) string {
+	value, defined, err := unwrapMaybe(actual)
+	switch {
+	case err != nil:
+		return fmt.Sprintf("%#v", actual)
+	case !defined:
+		return "None"
+	default:
+		return fmt.Sprintf("Some(%#v)", value)
+	}
+}
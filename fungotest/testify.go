@@ -0,0 +1,36 @@
+package fungotest
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+// AssertSome - asserts that the Maybe holds a value and returns it.
+func AssertSome[T any](t assert.TestingT, mb *maybe.Maybe[T], msgAndArgs ...any) (T, bool) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	value, err := mb.Get()
+	if err != nil {
+		return value, assert.Fail(t, fmt.Sprintf("Expected Some, got %s", describeMaybe(mb)), msgAndArgs...)
+	}
+
+	return value, true
+}
+
+// AssertNone - asserts that the Maybe is empty.
+func AssertNone[T any](t assert.TestingT, mb *maybe.Maybe[T], msgAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	if mb.IsDefined() {
+		return assert.Fail(t, fmt.Sprintf("Expected None, got %s", describeMaybe(mb)), msgAndArgs...)
+	}
+
+	return true
+}
@@ -0,0 +1,72 @@
+package fungotest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/fungotest"
+	"github.com/tompaz3/fungo/maybe"
+)
+
+type recordingT struct {
+	messages []string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+func Test_AssertSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		rec := &recordingT{}
+
+		res, ok := fungotest.AssertSome(rec, maybe.Some(1))
+
+		assert.True(t, ok)
+		assert.Equal(t, 1, res)
+		assert.Empty(t, rec.messages)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		rec := &recordingT{}
+
+		res, ok := fungotest.AssertSome(rec, maybe.None[int](), "user %d", 1)
+
+		assert.False(t, ok)
+		assert.Zero(t, res)
+		assert.Len(t, rec.messages, 1)
+		assert.Contains(t, rec.messages[0], "Expected Some, got None")
+		assert.Contains(t, rec.messages[0], "user 1")
+	})
+}
+
+func Test_AssertNone(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		rec := &recordingT{}
+
+		assert.True(t, fungotest.AssertNone(rec, maybe.None[int]()))
+		assert.Empty(t, rec.messages)
+	})
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		rec := &recordingT{}
+
+		assert.False(t, fungotest.AssertNone(rec, maybe.Some("john")))
+		assert.Len(t, rec.messages, 1)
+		assert.Contains(t, rec.messages[0], `Expected None, got Some("john")`)
+	})
+}
@@ -0,0 +1,60 @@
+package match_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+	match "github.com/tompaz3/fungo/match/error"
+)
+
+var _ = g.Describe("ErrorIs", func() {
+	g.When("error is the target error", func() {
+		g.It("should return the error and true", func() {
+			matchedErr, ok := match.ErrorIs(io.EOF, io.EOF)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(matchedErr).To(o.Equal(io.EOF))
+		})
+	})
+
+	g.When("error wraps the target error", func() {
+		g.It("should return the error and true", func() {
+			receivedErr := fmt.Errorf("reading failed: %w", io.EOF)
+			matchedErr, ok := match.ErrorIs(receivedErr, io.EOF)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(matchedErr).To(o.Equal(receivedErr))
+		})
+	})
+
+	g.When("joined error contains the target error", func() {
+		g.It("should return the error and true", func() {
+			receivedErr := errors.Join(io.ErrUnexpectedEOF, context.Canceled)
+			matchedErr, ok := match.ErrorIs(receivedErr, context.Canceled)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(matchedErr).To(o.Equal(receivedErr))
+		})
+	})
+
+	g.When("error doesn't match the target error", func() {
+		g.It("should return nil and false", func() {
+			matchedErr, ok := match.ErrorIs(io.ErrUnexpectedEOF, io.EOF)
+			o.Expect(ok).To(o.BeFalse())
+			o.Expect(matchedErr).To(o.BeZero())
+		})
+	})
+})
+
+var _ = g.Describe("Is", func() {
+	g.It("should compose with other predicates", func() {
+		pred := match.Is(io.EOF).Or(match.Is(context.Canceled))
+
+		_, ok := match.ErrorMatches(fmt.Errorf("wrapped: %w", context.Canceled), pred)
+		o.Expect(ok).To(o.BeTrue())
+
+		_, ok = match.ErrorMatches(context.DeadlineExceeded, pred)
+		o.Expect(ok).To(o.BeFalse())
+	})
+})
@@ -0,0 +1,54 @@
+package match
+
+// AllErrorsOfType - returns all the errors of the expected type (generic parameter) found in the error tree.
+// Unlike errors.As, it doesn't stop at the first match, but visits every wrapped and joined (errors.Join) error.
+func AllErrorsOfType[E error](err error) []E {
+	return AllErrorsOfTypeMatching[E](err, func(_ E) bool {
+		return true
+	})
+}
+
+// AllErrorsOfTypeMatching - returns all the errors of the expected type (generic parameter) found in the error tree,
+// which match given predicate.
+func AllErrorsOfTypeMatching[E error](err error, pred ErrorPredicate[E]) []E {
+	res := make([]E, 0)
+	walk(err, func(err error) {
+		if typedErr, ok := asType[E](err); ok && pred(typedErr) {
+			res = append(res, typedErr)
+		}
+	})
+
+	return res
+}
+
+// walk - visits all the errors in the tree in pre-order, the same order errors.Is and errors.As use.
+func walk(err error, visit func(err error)) {
+	if err == nil {
+		return
+	}
+
+	visit(err)
+
+	switch wrapped := err.(type) { //nolint:errorlint // we walk the error tree ourselves
+	case interface{ Unwrap() error }:
+		walk(wrapped.Unwrap(), visit)
+	case interface{ Unwrap() []error }:
+		for _, e := range wrapped.Unwrap() {
+			walk(e, visit)
+		}
+	}
+}
+
+// asType - tests if the error itself (without unwrapping) is of the expected type.
+func asType[E error](err error) (E, bool) {
+	if typedErr, ok := err.(E); ok { //nolint:errorlint // we walk the error tree ourselves
+		return typedErr, true
+	}
+
+	var typedErr E
+	if asErr, ok := err.(interface{ As(target any) bool }); ok && asErr.As(&typedErr) { //nolint:errorlint // as above
+		return typedErr, true
+	}
+
+	return typedErr, false
+}
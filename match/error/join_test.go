@@ -0,0 +1,82 @@
+package match_test
+
+import (
+	"errors"
+	"fmt"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+	match "github.com/tompaz3/fungo/match/error"
+)
+
+var _ = g.Describe("AllErrorsOfType", func() {
+	productErr1 := ProductNotFoundError{ProductID: "1"}
+	productErr2 := ProductNotFoundError{ProductID: "2"}
+	productErr3 := ProductNotFoundError{ProductID: "3"}
+	currencyErr := UnsupportedCurrencyError{Currency: "XYZ"}
+
+	g.When("error is joined", func() {
+		g.It("should return all the errors of the expected type in order", func() {
+			receivedErr := errors.Join(
+				productErr1,
+				currencyErr,
+				fmt.Errorf("wrapped: %w", productErr2),
+				errors.Join(productErr3),
+			)
+
+			typedErrs := match.AllErrorsOfType[ProductNotFoundError](receivedErr)
+			o.Expect(typedErrs).To(o.HaveExactElements(productErr1, productErr2, productErr3))
+		})
+	})
+
+	g.When("error wraps multiple errors", func() {
+		g.It("should return all the errors of the expected type", func() {
+			receivedErr := fmt.Errorf("failed: %w, %w", productErr1, productErr2)
+
+			typedErrs := match.AllErrorsOfType[ProductNotFoundError](receivedErr)
+			o.Expect(typedErrs).To(o.HaveExactElements(productErr1, productErr2))
+		})
+	})
+
+	g.When("error is a single error of the expected type", func() {
+		g.It("should return the error", func() {
+			typedErrs := match.AllErrorsOfType[ProductNotFoundError](productErr1)
+			o.Expect(typedErrs).To(o.HaveExactElements(productErr1))
+		})
+	})
+
+	g.When("no error of the expected type", func() {
+		g.It("should return empty slice", func() {
+			typedErrs := match.AllErrorsOfType[ProductNotFoundError](errors.Join(currencyErr))
+			o.Expect(typedErrs).To(o.BeEmpty())
+		})
+	})
+
+	g.When("error is nil", func() {
+		g.It("should return empty slice", func() {
+			typedErrs := match.AllErrorsOfType[ProductNotFoundError](nil)
+			o.Expect(typedErrs).To(o.BeEmpty())
+		})
+	})
+})
+
+var _ = g.Describe("AllErrorsOfTypeMatching", func() {
+	g.It("should return all the errors of the expected type matching the predicate", func() {
+		receivedErr := errors.Join(
+			ProductNotFoundError{ProductID: "1"},
+			ProductNotFoundError{ProductID: "2"},
+			ProductNotFoundError{ProductID: "3"},
+		)
+
+		typedErrs := match.AllErrorsOfTypeMatching[ProductNotFoundError](
+			receivedErr,
+			func(err ProductNotFoundError) bool {
+				return err.ProductID != "2"
+			},
+		)
+		o.Expect(typedErrs).To(o.HaveExactElements(
+			ProductNotFoundError{ProductID: "1"},
+			ProductNotFoundError{ProductID: "3"},
+		))
+	})
+})
@@ -45,6 +45,21 @@ func ErrorMatches(err error, pred ErrorPredicate[error]) (error, bool) {
 	return empty, false
 }
 
+// ErrorIs - tests if given error matches the target error (using errors.Is, which walks wrapped and joined errors)
+// and returns the error with a boolean test result.
+//
+//nolint:revive // this function is used to test error against the target and return it in case of success
+func ErrorIs(err, target error) (error, bool) {
+	return ErrorMatches(err, Is(target))
+}
+
+// Is - creates a predicate testing if the error matches the target error (using errors.Is).
+func Is(target error) ErrorPredicate[error] {
+	return func(err error) bool {
+		return errors.Is(err, target)
+	}
+}
+
 func (p ErrorPredicate[E]) And(pred ErrorPredicate[E]) ErrorPredicate[E] {
 	return func(err E) bool {
 		return p(err) && pred(err)
@@ -0,0 +1,117 @@
+package patternmatch_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+	errmatch "github.com/tompaz3/fungo/match/error/patternmatch"
+)
+
+var _ = g.Describe("Is", func() {
+	g.It("should match wrapped target error", func() {
+		receivedErr := fmt.Errorf("reading failed: %w", io.EOF)
+		theErr, ok := errmatch.Is(io.EOF).Test(receivedErr)
+		o.Expect(ok).To(o.BeTrue())
+		o.Expect(theErr).To(o.Equal(receivedErr))
+	})
+
+	g.It("should match joined target error", func() {
+		_, ok := errmatch.Is(context.Canceled).Test(errors.Join(io.EOF, context.Canceled))
+		o.Expect(ok).To(o.BeTrue())
+	})
+
+	g.It("should not match other error", func() {
+		_, ok := errmatch.Is(io.EOF).Test(io.ErrUnexpectedEOF)
+		o.Expect(ok).To(o.BeFalse())
+	})
+
+	g.It("should combine with typed predicate", func() {
+		pred := errmatch.Type[PaymentGatewayRejectedError]().OrMatches(errmatch.Is(context.DeadlineExceeded))
+
+		_, ok := pred.Test(fmt.Errorf("wrapped: %w", context.DeadlineExceeded))
+		o.Expect(ok).To(o.BeTrue())
+	})
+})
+
+var _ = g.Describe("CaseIs", func() {
+	g.When("error matches the target", func() {
+		g.It("should retrieve value", func() {
+			executedMethods := make([]string, 0)
+			res, err := errmatch.Switch(
+				fmt.Errorf("reading failed: %w", io.EOF),
+				errmatch.CaseIs(context.Canceled, func(_ error) (int, error) {
+					executedMethods = append(executedMethods, "Canceled")
+					return 1, nil
+				}),
+				errmatch.CaseIs(io.EOF, func(_ error) (int, error) {
+					executedMethods = append(executedMethods, "EOF")
+					return 2, nil
+				}),
+			)
+
+			o.Expect(res).To(o.Equal(2))
+			o.Expect(err).ShouldNot(o.HaveOccurred())
+			o.Expect(executedMethods).To(o.HaveExactElements("EOF"))
+		})
+	})
+
+	g.When("no match found", func() {
+		g.It("should report the target in tried cases", func() {
+			_, err := errmatch.On[int](io.ErrUnexpectedEOF).
+				Case(errmatch.Described(errmatch.Is(io.EOF), func(_ error) (int, error) {
+					return 1, nil
+				})).
+				Match()
+
+			var noMatchErr *errmatch.NoMatchFoundError
+			o.Expect(errors.As(err, &noMatchErr)).To(o.BeTrue())
+			o.Expect(noMatchErr.Cases).To(o.HaveExactElements("Is[EOF]"))
+		})
+
+		g.It("should report composed predicates in tried cases", func() {
+			_, err := errmatch.On[int](io.EOF).
+				Case(
+					errmatch.Described(errmatch.Is(io.EOF).Not(), func(_ error) (int, error) {
+						return 1, nil
+					}),
+					errmatch.Described(
+						errmatch.Is(context.Canceled).Or(errmatch.Is(context.DeadlineExceeded).Test),
+						func(_ error) (int, error) {
+							return 2, nil
+						},
+					),
+					errmatch.Described(errmatch.Type[PaymentGatewayRejectedError](), func(_ PaymentGatewayRejectedError) (int, error) {
+						return 3, nil
+					}),
+					errmatch.Described(
+						errmatch.Type[PaymentGatewayRejectedError]().OrIs(io.ErrUnexpectedEOF),
+						func(_ error) (int, error) {
+							return 4, nil
+						},
+					),
+				).
+				Match()
+
+			var noMatchErr *errmatch.NoMatchFoundError
+			o.Expect(errors.As(err, &noMatchErr)).To(o.BeTrue())
+			o.Expect(noMatchErr.Cases).To(o.HaveExactElements(
+				"Is[EOF].Not()",
+				"Is[context canceled].Or(...)",
+				"Type[patternmatch_test.PaymentGatewayRejectedError]",
+				"Type[patternmatch_test.PaymentGatewayRejectedError].OrIs(unexpected EOF)",
+			))
+		})
+
+		g.It("should return ErrNoMatchFound from the case", func() {
+			_, err := errmatch.CaseIs(io.EOF, func(_ error) (int, error) {
+				return 1, nil
+			})(io.ErrUnexpectedEOF)
+
+			o.Expect(err).To(o.BeIdenticalTo(errmatch.ErrNoMatchFound))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+package patternmatch_test
+
+import (
+	"errors"
+	"fmt"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+	errmatch "github.com/tompaz3/fungo/match/error/patternmatch"
+)
+
+var _ = g.Describe("CaseTypeAll", func() {
+	receivedErr := errors.Join(
+		ProductNotFoundError{ProductID: "1"},
+		UnsupportedCurrencyError{Currency: "XYZ"},
+		fmt.Errorf("wrapped: %w", ProductNotFoundError{ProductID: "2"}),
+	)
+
+	g.When("joined error contains errors of the type", func() {
+		g.It("should invoke case for each error", func() {
+			res, err := errmatch.Switch(
+				receivedErr,
+				errmatch.CaseTypeAll(func(_ InsufficientFundsError) (string, error) {
+					return "insufficient funds", nil
+				}),
+				errmatch.CaseTypeAll(func(e ProductNotFoundError) (string, error) {
+					return e.ProductID, nil
+				}),
+			)
+
+			o.Expect(err).ShouldNot(o.HaveOccurred())
+			o.Expect(res).To(o.HaveExactElements("1", "2"))
+		})
+
+		g.It("should stop at the first error returned by the case", func() {
+			executed := make([]string, 0)
+			res, err := errmatch.Switch(
+				receivedErr,
+				errmatch.CaseTypeAll(func(e ProductNotFoundError) (string, error) {
+					executed = append(executed, e.ProductID)
+					return "", errTestError
+				}),
+			)
+
+			o.Expect(err).To(o.Equal(errTestError))
+			o.Expect(res).To(o.BeNil())
+			o.Expect(executed).To(o.HaveExactElements("1"))
+		})
+	})
+
+	g.When("no error of the type", func() {
+		g.It("should report the case as tried", func() {
+			_, err := errmatch.On[[]string](receivedErr).
+				Case(errmatch.DescribedAs(
+					"insufficient funds",
+					errmatch.CaseTypeAll(func(_ InsufficientFundsError) (string, error) {
+						return "insufficient funds", nil
+					}),
+				)).
+				Match()
+
+			var noMatchErr *errmatch.NoMatchFoundError
+			o.Expect(errors.As(err, &noMatchErr)).To(o.BeTrue())
+			o.Expect(noMatchErr.Cases).To(o.HaveExactElements("insufficient funds"))
+		})
+	})
+})
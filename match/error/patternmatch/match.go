@@ -2,6 +2,9 @@ package patternmatch
 
 import (
 	"errors"
+	"fmt"
+
+	match "github.com/tompaz3/fungo/match/error"
 )
 
 var ErrNoMatchFound = errors.New("no match found")
@@ -49,28 +52,55 @@ func Case[E error, R any](pred ErrPredicate[E], fn MatchFunc[E, R]) ResultFunc[R
 }
 
 func Type[E error]() TypedErrPredicate[E] {
-	return TypedErrPredicate[E]{
-		typePred: RawErrPredicate[E]{
-			pred: func(err error) (E, bool) {
-				var theErr E
-				if errors.As(err, &theErr) {
-					return theErr, true
-				}
+	typePred := RawErrPredicate[E]{
+		pred: func(err error) (E, bool) {
+			var theErr E
+			if errors.As(err, &theErr) {
+				return theErr, true
+			}
 
-				return theErr, false
-			},
-		},
-		pred: func(_ E) bool {
-			return true
+			return theErr, false
 		},
 	}
+
+	return TypedErrPredicate[E]{
+		typePred: typePred,
+		pred:     typePred.pred,
+	}
 }
 
 func CaseType[E error, R any](fn MatchFunc[E, R]) ResultFunc[R] {
 	return Case[E, R](Type[E](), fn)
 }
 
-func Matches(pred PredicateFunc[error]) ErrPredicate[error] {
+// CaseTypeAll - matches all the errors of type E found in the error tree (including errors joined with errors.Join)
+// and invokes fn once per matching error, collecting the results. Stops at the first error returned by fn.
+func CaseTypeAll[E error, R any](fn MatchFunc[E, R]) ResultFunc[[]R] {
+	return func(err error) ([]R, error) {
+		typedErrs := match.AllErrorsOfType[E](err)
+		if len(typedErrs) == 0 {
+			return nil, ErrNoMatchFound
+		}
+
+		res := make([]R, 0, len(typedErrs))
+		for _, typedErr := range typedErrs {
+			val, fnErr := fn(typedErr)
+			if fnErr != nil {
+				return nil, fnErr
+			}
+			res = append(res, val)
+		}
+
+		return res, nil
+	}
+}
+
+// CaseIs - matches errors matching the target error (using errors.Is).
+func CaseIs[R any](target error, fn MatchFunc[error, R]) ResultFunc[R] {
+	return Case[error, R](Is(target), fn)
+}
+
+func Matches(pred PredicateFunc[error]) RawErrPredicate[error] {
 	return RawErrPredicate[error]{
 		pred: func(err error) (error, bool) {
 			return err, pred(err)
@@ -78,18 +108,43 @@ func Matches(pred PredicateFunc[error]) ErrPredicate[error] {
 	}
 }
 
+// MatchesFunc - creates a predicate, which extracts the error of type E from the tested error using given function.
+func MatchesFunc[E error](pred ErrPredicateFunc[E]) RawErrPredicate[E] {
+	return RawErrPredicate[E]{pred: pred}
+}
+
+// Is - creates a predicate matching errors, which match the target error (using errors.Is).
+// Wrapped and joined errors are tested the same way errors.Is does.
+func Is(target error) RawErrPredicate[error] {
+	return RawErrPredicate[error]{
+		pred: func(err error) (error, bool) {
+			return err, errors.Is(err, target)
+		},
+		desc: fmt.Sprintf("Is[%v]", target),
+	}
+}
+
 type ErrPredicate[E error] interface {
 	Test(err error) (E, bool)
 }
 
 type RawErrPredicate[E error] struct {
 	pred ErrPredicateFunc[E]
+	desc string
 }
 
 func (p RawErrPredicate[E]) Test(err error) (E, bool) {
 	return p.pred(err)
 }
 
+func (p RawErrPredicate[E]) String() string {
+	if p.desc != "" {
+		return p.desc
+	}
+
+	return "Matches[" + typeName[E]() + "]"
+}
+
 func (p RawErrPredicate[E]) And(pred ErrPredicateFunc[E]) RawErrPredicate[E] {
 	return RawErrPredicate[E]{
 		pred: func(err error) (E, bool) {
@@ -100,6 +155,7 @@ func (p RawErrPredicate[E]) And(pred ErrPredicateFunc[E]) RawErrPredicate[E] {
 
 			return pred(err)
 		},
+		desc: p.String() + ".And(...)",
 	}
 }
 
@@ -113,43 +169,176 @@ func (p RawErrPredicate[E]) Or(pred ErrPredicateFunc[E]) RawErrPredicate[E] {
 
 			return pred(err)
 		},
+		desc: p.String() + ".Or(...)",
+	}
+}
+
+func (p RawErrPredicate[E]) Not() RawErrPredicate[E] {
+	return RawErrPredicate[E]{
+		pred: func(err error) (E, bool) {
+			theErr, ok := p.pred(err)
+
+			return theErr, !ok
+		},
+		desc: p.String() + ".Not()",
 	}
 }
 
+// TypedErrPredicate - predicate testing the error type first (using errors.As).
+// Predicates composed with And, Or and Not keep the type check,
+// AndMatches and AndIs combine it with predicates testing the whole error
+// (e.g. RawErrPredicate created with Matches or errors.Is sentinel checks)
+// and AndPredicate and OrPredicate combine it with other predicates of the same error type.
+//
+// OrMatches and OrIs match errors, which are not of type E, hence they return RawErrPredicate[error],
+// which provides the matched error (either the typed error or the tested error) rather than a zero E.
 type TypedErrPredicate[E error] struct {
 	typePred ErrPredicate[E]
-	pred     TypedErrPredicateFunc[E]
+	pred     ErrPredicateFunc[E]
+	desc     string
 }
 
 func (p TypedErrPredicate[E]) Test(err error) (E, bool) {
-	typeErr, ok := p.typePred.Test(err)
-	if !ok {
-		return typeErr, false
+	return p.pred(err)
+}
+
+func (p TypedErrPredicate[E]) String() string {
+	if p.desc != "" {
+		return p.desc
 	}
 
-	return typeErr, p.pred(typeErr)
+	return "Type[" + typeName[E]() + "]"
 }
 
+// And - matches errors of type E, which match this predicate and given function.
 func (p TypedErrPredicate[E]) And(pred TypedErrPredicateFunc[E]) TypedErrPredicate[E] {
-	return TypedErrPredicate[E]{
-		pred: func(err E) bool {
-			if ok := p.pred(err); !ok {
-				return false
-			}
+	return p.with(".And(...)", func(err error) (E, bool) {
+		theErr, ok := p.pred(err)
+		if !ok {
+			return theErr, false
+		}
 
-			return pred(err)
-		},
-	}
+		return theErr, pred(theErr)
+	})
 }
 
+// Or - matches errors, which match this predicate or are of type E and match given function.
 func (p TypedErrPredicate[E]) Or(pred TypedErrPredicateFunc[E]) TypedErrPredicate[E] {
+	return p.with(".Or(...)", func(err error) (E, bool) {
+		if theErr, ok := p.pred(err); ok {
+			return theErr, true
+		}
+
+		theErr, ok := p.typePred.Test(err)
+		if !ok {
+			return theErr, false
+		}
+
+		return theErr, pred(theErr)
+	})
+}
+
+// Not - matches errors of type E, which don't match this predicate.
+func (p TypedErrPredicate[E]) Not() TypedErrPredicate[E] {
+	return p.with(".Not()", func(err error) (E, bool) {
+		theErr, ok := p.typePred.Test(err)
+		if !ok {
+			return theErr, false
+		}
+
+		_, matched := p.pred(err)
+
+		return theErr, !matched
+	})
+}
+
+// AndMatches - matches errors, which match both this predicate and given predicate.
+func (p TypedErrPredicate[E]) AndMatches(pred ErrPredicate[error]) TypedErrPredicate[E] {
+	return p.with(".AndMatches("+describe(pred)+")", func(err error) (E, bool) {
+		theErr, ok := p.pred(err)
+		if !ok {
+			return theErr, false
+		}
+
+		_, ok = pred.Test(err)
+
+		return theErr, ok
+	})
+}
+
+// OrMatches - matches errors, which match this predicate or given predicate.
+// The matched error is the typed error if matched by this predicate, or the tested error otherwise.
+func (p TypedErrPredicate[E]) OrMatches(pred ErrPredicate[error]) RawErrPredicate[error] {
+	return p.or(".OrMatches("+describe(pred)+")", func(err error) bool {
+		_, ok := pred.Test(err)
+		return ok
+	})
+}
+
+// AndPredicate - matches errors, which match both this predicate and given predicate of the same error type.
+func (p TypedErrPredicate[E]) AndPredicate(pred ErrPredicate[E]) TypedErrPredicate[E] {
+	return p.with(".AndPredicate("+describe(pred)+")", func(err error) (E, bool) {
+		theErr, ok := p.pred(err)
+		if !ok {
+			return theErr, false
+		}
+
+		_, ok = pred.Test(err)
+
+		return theErr, ok
+	})
+}
+
+// OrPredicate - matches errors, which match this predicate or given predicate of the same error type.
+func (p TypedErrPredicate[E]) OrPredicate(pred ErrPredicate[E]) TypedErrPredicate[E] {
+	return p.with(".OrPredicate("+describe(pred)+")", func(err error) (E, bool) {
+		if theErr, ok := p.pred(err); ok {
+			return theErr, true
+		}
+
+		return pred.Test(err)
+	})
+}
+
+// AndIs - matches errors, which match this predicate and errors.Is(err, target).
+func (p TypedErrPredicate[E]) AndIs(target error) TypedErrPredicate[E] {
+	return p.with(fmt.Sprintf(".AndIs(%v)", target), func(err error) (E, bool) {
+		theErr, ok := p.pred(err)
+		if !ok {
+			return theErr, false
+		}
+
+		return theErr, errors.Is(err, target)
+	})
+}
+
+// OrIs - matches errors, which match this predicate or errors.Is(err, target).
+// The matched error is the typed error if matched by this predicate, or the tested error otherwise.
+func (p TypedErrPredicate[E]) OrIs(target error) RawErrPredicate[error] {
+	return p.or(fmt.Sprintf(".OrIs(%v)", target), func(err error) bool {
+		return errors.Is(err, target)
+	})
+}
+
+// with - creates a predicate composed of this predicate, described by its description followed by descSuffix.
+func (p TypedErrPredicate[E]) with(descSuffix string, pred ErrPredicateFunc[E]) TypedErrPredicate[E] {
 	return TypedErrPredicate[E]{
-		pred: func(err E) bool {
-			if ok := p.pred(err); ok {
-				return true
+		typePred: p.typePred,
+		pred:     pred,
+		desc:     p.String() + descSuffix,
+	}
+}
+
+// or - creates a predicate matching errors, which match this predicate or given function, described like with.
+func (p TypedErrPredicate[E]) or(descSuffix string, pred func(err error) bool) RawErrPredicate[error] {
+	return RawErrPredicate[error]{
+		pred: func(err error) (error, bool) {
+			if theErr, ok := p.pred(err); ok {
+				return theErr, true
 			}
 
-			return pred(err)
+			return err, pred(err)
 		},
+		desc: p.String() + descSuffix,
 	}
 }
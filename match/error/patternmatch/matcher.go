@@ -0,0 +1,126 @@
+package patternmatch
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// NoMatchFoundError - returned by Matcher when none of the cases matched the error and no default case was given.
+// It lists all the cases, which were tried, and unwraps to ErrNoMatchFound.
+type NoMatchFoundError struct {
+	Err   error
+	Cases []string
+}
+
+func (e *NoMatchFoundError) Error() string {
+	return fmt.Sprintf("%s for error %q, tried cases: [%s]", ErrNoMatchFound, e.Err, strings.Join(e.Cases, ", "))
+}
+
+func (e *NoMatchFoundError) Unwrap() error {
+	return ErrNoMatchFound
+}
+
+// MatchCase - case evaluated by Matcher.
+// Cases not matching the error are reported by their description if they implement fmt.Stringer (e.g. DescribedCase)
+// or by their position otherwise (e.g. ResultFunc).
+type MatchCase[R any] interface {
+	Match(err error) (R, error)
+}
+
+func (f ResultFunc[R]) Match(err error) (R, error) {
+	return f(err)
+}
+
+// DescribedCase - case carrying its description, which is reported by Matcher if the case doesn't match the error.
+type DescribedCase[R any] struct {
+	desc string
+	fn   ResultFunc[R]
+}
+
+// Described - creates a case like Case, described by the predicate (e.g. Type[*HTTPError] or Is[EOF].Not()).
+func Described[E error, R any](pred ErrPredicate[E], fn MatchFunc[E, R]) DescribedCase[R] {
+	return DescribedCase[R]{desc: describe(pred), fn: Case(pred, fn)}
+}
+
+// DescribedAs - describes the case (e.g. created with CaseTypeAll) with the given description.
+func DescribedAs[R any](desc string, fn ResultFunc[R]) DescribedCase[R] {
+	return DescribedCase[R]{desc: desc, fn: fn}
+}
+
+func (c DescribedCase[R]) Match(err error) (R, error) {
+	return c.fn(err)
+}
+
+func (c DescribedCase[R]) String() string {
+	return c.desc
+}
+
+// Matcher - fluent pattern match builder, which evaluates cases in order.
+// Go doesn't support generic methods, hence typed cases are created with the Described, Case and CaseType functions.
+type Matcher[R any] struct {
+	err   error
+	cases []MatchCase[R]
+}
+
+// On - initiates pattern matching of the error, with all the cases returning R.
+func On[R any](err error) *Matcher[R] {
+	return &Matcher[R]{err: err}
+}
+
+// Case - adds cases (e.g. created with Described, Case or CaseType functions) to the matcher.
+func (m *Matcher[R]) Case(cases ...MatchCase[R]) *Matcher[R] {
+	for _, c := range cases {
+		if fn, isFunc := c.(ResultFunc[R]); c == nil || (isFunc && fn == nil) {
+			continue
+		}
+
+		m.cases = append(m.cases, c)
+	}
+
+	return m
+}
+
+// Default - evaluates the cases and falls back to fn if none of them matched the error.
+func (m *Matcher[R]) Default(fn ResultFunc[R]) (R, error) {
+	res, err := m.Match()
+	if errors.Is(err, ErrNoMatchFound) {
+		return fn(m.err)
+	}
+
+	return res, err
+}
+
+// Match - evaluates the cases and returns *NoMatchFoundError listing all the tried cases if none of them matched.
+func (m *Matcher[R]) Match() (R, error) {
+	tried := make([]string, 0, len(m.cases))
+	for i, c := range m.cases {
+		res, err := c.Match(m.err)
+		if !errors.Is(err, ErrNoMatchFound) {
+			return res, err
+		}
+
+		if stringer, ok := c.(fmt.Stringer); ok {
+			tried = append(tried, stringer.String())
+		} else {
+			tried = append(tried, fmt.Sprintf("case #%d", i+1))
+		}
+	}
+
+	var empty R
+
+	return empty, &NoMatchFoundError{Err: m.err, Cases: tried}
+}
+
+func describe(pred any) string {
+	if stringer, ok := pred.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+
+	return fmt.Sprintf("%T", pred)
+}
+
+func typeName[E any]() string {
+	return reflect.TypeFor[E]().String()
+}
@@ -0,0 +1,149 @@
+package patternmatch_test
+
+import (
+	"errors"
+	"fmt"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+	errmatch "github.com/tompaz3/fungo/match/error/patternmatch"
+)
+
+var _ = g.Describe("On", func() {
+	receivedErr := PaymentGatewayRejectedError{
+		ReasonCode:    "100",
+		ReasonMessage: "Payment rejected",
+	}
+
+	g.When("error matches a case", func() {
+		g.It("should retrieve value of the first matching case", func() {
+			executedMethods := make([]string, 0)
+			res, err := errmatch.On[int](receivedErr).
+				Case(errmatch.CaseType(func(_ InsufficientFundsError) (int, error) {
+					executedMethods = append(executedMethods, "InsufficientFundsError")
+					return 1, nil
+				})).
+				Case(errmatch.CaseType(func(_ PaymentGatewayRejectedError) (int, error) {
+					executedMethods = append(executedMethods, "PaymentGatewayRejectedError")
+					return 2, nil
+				})).
+				Case(errmatch.Case(
+					errmatch.Matches(func(_ error) bool { return true }),
+					func(_ error) (int, error) {
+						executedMethods = append(executedMethods, "Matches")
+						return 3, nil
+					},
+				)).
+				Match()
+
+			o.Expect(res).To(o.Equal(2))
+			o.Expect(err).ShouldNot(o.HaveOccurred())
+			o.Expect(executedMethods).To(o.HaveExactElements("PaymentGatewayRejectedError"))
+		})
+
+		g.It("should retrieve error returned by the matching case", func() {
+			res, err := errmatch.On[int](receivedErr).
+				Case(errmatch.CaseType(func(_ PaymentGatewayRejectedError) (int, error) {
+					return 0, errTestError
+				})).
+				Default(func(_ error) (int, error) {
+					return 1, nil
+				})
+
+			o.Expect(res).To(o.BeZero())
+			o.Expect(err).To(o.Equal(errTestError))
+		})
+	})
+
+	g.When("no match found", func() {
+		g.Context("and cases are not described", func() {
+			g.It("should report positions of the tried cases", func() {
+				_, err := errmatch.On[int](receivedErr).
+					Case(errmatch.CaseType(func(_ InsufficientFundsError) (int, error) {
+						return 1, nil
+					})).
+					Case(nil, errmatch.ResultFunc[int](nil)).
+					Case(errmatch.CaseIs(errTestError, func(_ error) (int, error) {
+						return 2, nil
+					})).
+					Match()
+
+				var noMatchErr *errmatch.NoMatchFoundError
+				o.Expect(errors.As(err, &noMatchErr)).To(o.BeTrue())
+				o.Expect(noMatchErr.Cases).To(o.HaveExactElements("case #1", "case #2"))
+			})
+		})
+
+		g.Context("and no default case", func() {
+			g.It("should return error listing the tried cases", func() {
+				rawCaseCalls := 0
+				res, err := errmatch.On[int](receivedErr).
+					Case(
+						errmatch.Described(errmatch.Type[InsufficientFundsError](), func(_ InsufficientFundsError) (int, error) {
+							return 1, nil
+						}),
+						errmatch.Described(errmatch.Type[ProductNotFoundError](), func(_ ProductNotFoundError) (int, error) {
+							return 2, nil
+						}),
+					).
+					Case(errmatch.Described(
+						errmatch.Matches(func(_ error) bool { return false }),
+						func(_ error) (int, error) {
+							return 3, nil
+						},
+					)).
+					Case(errmatch.ResultFunc[int](func(_ error) (int, error) {
+						rawCaseCalls++
+						return 0, errmatch.ErrNoMatchFound
+					})).
+					Case(errmatch.DescribedAs("custom", func(_ error) (int, error) {
+						return 0, errmatch.ErrNoMatchFound
+					})).
+					Match()
+
+				o.Expect(res).To(o.BeZero())
+				o.Expect(err).To(o.MatchError(errmatch.ErrNoMatchFound))
+				o.Expect(rawCaseCalls).To(o.Equal(1))
+
+				var noMatchErr *errmatch.NoMatchFoundError
+				o.Expect(errors.As(err, &noMatchErr)).To(o.BeTrue())
+				o.Expect(noMatchErr.Err).To(o.Equal(receivedErr))
+				o.Expect(noMatchErr.Cases).To(o.HaveExactElements(
+					"Type[patternmatch_test.InsufficientFundsError]",
+					"Type[patternmatch_test.ProductNotFoundError]",
+					"Matches[error]",
+					"case #4",
+					"custom",
+				))
+				o.Expect(err.Error()).To(o.Equal(fmt.Sprintf(
+					"no match found for error %q, tried cases: [%s, %s, %s, %s, %s]",
+					receivedErr.Error(),
+					"Type[patternmatch_test.InsufficientFundsError]",
+					"Type[patternmatch_test.ProductNotFoundError]",
+					"Matches[error]",
+					"case #4",
+					"custom",
+				)))
+			})
+		})
+
+		g.Context("and default case", func() {
+			g.It("should return default result", func() {
+				executedMethods := make([]string, 0)
+				res, err := errmatch.On[int](receivedErr).
+					Case(errmatch.CaseType(func(_ InsufficientFundsError) (int, error) {
+						executedMethods = append(executedMethods, "InsufficientFundsError")
+						return 1, nil
+					})).
+					Default(func(_ error) (int, error) {
+						executedMethods = append(executedMethods, "Default")
+						return 2, nil
+					})
+
+				o.Expect(res).To(o.Equal(2))
+				o.Expect(err).ShouldNot(o.HaveOccurred())
+				o.Expect(executedMethods).To(o.HaveExactElements("Default"))
+			})
+		})
+	})
+})
@@ -0,0 +1,277 @@
+package patternmatch_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+	errmatch "github.com/tompaz3/fungo/match/error/patternmatch"
+)
+
+var _ = g.Describe("TypedErrPredicate", func() {
+	rejectedErr := PaymentGatewayRejectedError{
+		ReasonCode:    "100",
+		ReasonMessage: "Payment rejected",
+	}
+	wrappedRejectedErr := fmt.Errorf("wrapped: %w", rejectedErr)
+	otherErr := ProductNotFoundError{ProductID: "1"}
+	deadlineErr := fmt.Errorf("wrapped: %w", context.DeadlineExceeded)
+
+	codeIs := func(code string) errmatch.TypedErrPredicateFunc[PaymentGatewayRejectedError] {
+		return func(err PaymentGatewayRejectedError) bool {
+			return err.ReasonCode == code
+		}
+	}
+
+	g.Describe("And", func() {
+		g.It("should match error of the type matching the predicate", func() {
+			theErr, ok := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("100")).Test(wrappedRejectedErr)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(rejectedErr))
+		})
+
+		g.It("should not match error of the type not matching the predicate", func() {
+			_, ok := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("200")).Test(rejectedErr)
+			o.Expect(ok).To(o.BeFalse())
+		})
+
+		g.It("should not match error of a different type without invoking the predicate", func() {
+			predicateExecuted := false
+			_, ok := errmatch.Type[PaymentGatewayRejectedError]().
+				And(func(_ PaymentGatewayRejectedError) bool {
+					predicateExecuted = true
+					return true
+				}).
+				Test(otherErr)
+			o.Expect(ok).To(o.BeFalse())
+			o.Expect(predicateExecuted).To(o.BeFalse())
+		})
+	})
+
+	g.Describe("Or", func() {
+		g.It("should match error of the type matching any of the predicates", func() {
+			pred := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("200")).Or(codeIs("100"))
+			theErr, ok := pred.Test(rejectedErr)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(rejectedErr))
+		})
+
+		g.It("should not match error of a different type", func() {
+			pred := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("200")).
+				Or(func(_ PaymentGatewayRejectedError) bool { return true })
+			_, ok := pred.Test(otherErr)
+			o.Expect(ok).To(o.BeFalse())
+		})
+	})
+
+	g.Describe("Not", func() {
+		g.It("should match error of the type not matching the predicate", func() {
+			theErr, ok := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("200")).Not().Test(rejectedErr)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(rejectedErr))
+		})
+
+		g.It("should not match error of the type matching the predicate", func() {
+			_, ok := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("100")).Not().Test(rejectedErr)
+			o.Expect(ok).To(o.BeFalse())
+		})
+
+		g.It("should not match error of a different type", func() {
+			_, ok := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("100")).Not().Test(otherErr)
+			o.Expect(ok).To(o.BeFalse())
+		})
+	})
+
+	g.Describe("AndMatches", func() {
+		isWrapped := errmatch.Matches(func(err error) bool {
+			return errors.Unwrap(err) != nil
+		})
+
+		g.It("should match error of the type matching the raw predicate", func() {
+			theErr, ok := errmatch.Type[PaymentGatewayRejectedError]().AndMatches(isWrapped).Test(wrappedRejectedErr)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(rejectedErr))
+		})
+
+		g.It("should not match error of the type not matching the raw predicate", func() {
+			_, ok := errmatch.Type[PaymentGatewayRejectedError]().AndMatches(isWrapped).Test(rejectedErr)
+			o.Expect(ok).To(o.BeFalse())
+		})
+	})
+
+	g.Describe("OrMatches", func() {
+		isProductNotFound := errmatch.Matches(func(err error) bool {
+			return err.Error() == otherErr.Error()
+		})
+
+		g.It("should match error of the type or matching the raw predicate", func() {
+			pred := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("100")).OrMatches(isProductNotFound)
+
+			theErr, ok := pred.Test(rejectedErr)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(rejectedErr))
+
+			theErr, ok = pred.Test(otherErr)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(otherErr))
+
+			_, ok = pred.Test(deadlineErr)
+			o.Expect(ok).To(o.BeFalse())
+		})
+	})
+
+	g.Describe("AndIs and OrIs", func() {
+		g.It("should match error of the type or the sentinel error", func() {
+			pred := errmatch.Type[PaymentGatewayRejectedError]().And(codeIs("100")).OrIs(context.DeadlineExceeded)
+
+			theErr, ok := pred.Test(wrappedRejectedErr)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(rejectedErr))
+
+			theErr, ok = pred.Test(deadlineErr)
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(deadlineErr))
+
+			_, ok = pred.Test(otherErr)
+			o.Expect(ok).To(o.BeFalse())
+		})
+
+		g.It("should provide the typed error when matched by the typed predicate", func() {
+			pred := errmatch.Type[*HTTPError]().
+				And(func(err *HTTPError) bool { return err.Status >= 500 }).
+				OrIs(context.DeadlineExceeded)
+
+			_, ok := pred.Test(&HTTPError{Status: 404})
+			o.Expect(ok).To(o.BeFalse())
+
+			theErr, ok := pred.Test(fmt.Errorf("wrapped: %w", &HTTPError{Status: 503}))
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr).To(o.Equal(&HTTPError{Status: 503}))
+		})
+
+		g.It("should pass the matched error to the case", func() {
+			handle := func(err error) (string, error) {
+				return errmatch.Switch(err,
+					errmatch.Case(
+						errmatch.Type[*HTTPError]().
+							And(func(e *HTTPError) bool { return e.Status >= 500 }).
+							OrIs(context.DeadlineExceeded),
+						func(e error) (string, error) {
+							var httpErr *HTTPError
+							if errors.As(e, &httpErr) {
+								return fmt.Sprintf("server error %d", httpErr.Status), nil
+							}
+
+							return "timeout: " + e.Error(), nil
+						},
+					),
+				)
+			}
+
+			var res string
+			o.Expect(func() {
+				res, _ = handle(fmt.Errorf("wrap: %w", context.DeadlineExceeded))
+			}).NotTo(o.Panic())
+			o.Expect(res).To(o.Equal("timeout: wrap: context deadline exceeded"))
+
+			res, err := handle(&HTTPError{Status: 503})
+			o.Expect(err).NotTo(o.HaveOccurred())
+			o.Expect(res).To(o.Equal("server error 503"))
+
+			_, err = handle(&HTTPError{Status: 404})
+			o.Expect(err).To(o.Equal(errmatch.ErrNoMatchFound))
+		})
+
+		g.It("should match error of the type wrapping the sentinel error", func() {
+			joinedErr := errors.Join(rejectedErr, context.Canceled)
+			pred := errmatch.Type[PaymentGatewayRejectedError]().AndIs(context.Canceled)
+
+			_, ok := pred.Test(joinedErr)
+			o.Expect(ok).To(o.BeTrue())
+
+			_, ok = pred.Test(rejectedErr)
+			o.Expect(ok).To(o.BeFalse())
+		})
+	})
+})
+
+var _ = g.Describe("TypedErrPredicate AndPredicate and OrPredicate", func() {
+	isServerError := errmatch.MatchesFunc(func(err error) (*HTTPError, bool) {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.Status >= 500 {
+			return httpErr, true
+		}
+
+		return nil, false
+	})
+	isNotFound := errmatch.Type[*HTTPError]().And(func(err *HTTPError) bool { return err.Status == 404 })
+
+	g.It("should match error matching both predicates", func() {
+		pred := errmatch.Type[*HTTPError]().AndPredicate(isServerError)
+
+		theErr, ok := pred.Test(fmt.Errorf("wrapped: %w", &HTTPError{Status: 503}))
+		o.Expect(ok).To(o.BeTrue())
+		o.Expect(theErr.Status).To(o.Equal(503))
+
+		_, ok = pred.Test(&HTTPError{Status: 404})
+		o.Expect(ok).To(o.BeFalse())
+	})
+
+	g.It("should match error matching any of the predicates", func() {
+		pred := errmatch.Type[*HTTPError]().
+			And(func(err *HTTPError) bool { return err.Status == 400 }).
+			OrPredicate(isServerError).
+			OrPredicate(isNotFound)
+
+		for _, status := range []int{400, 404, 503} {
+			theErr, ok := pred.Test(&HTTPError{Status: status})
+			o.Expect(ok).To(o.BeTrue())
+			o.Expect(theErr.Status).To(o.Equal(status))
+		}
+
+		_, ok := pred.Test(&HTTPError{Status: 401})
+		o.Expect(ok).To(o.BeFalse())
+
+		_, ok = pred.Test(context.Canceled)
+		o.Expect(ok).To(o.BeFalse())
+	})
+
+	g.It("should invoke typed predicates composed after OrPredicate with the matched error", func() {
+		pred := errmatch.Type[*HTTPError]().
+			And(func(err *HTTPError) bool { return err.Status == 400 }).
+			OrPredicate(isServerError).
+			And(func(err *HTTPError) bool { return err.Status != 501 })
+
+		_, ok := pred.Test(&HTTPError{Status: 503})
+		o.Expect(ok).To(o.BeTrue())
+
+		_, ok = pred.Test(&HTTPError{Status: 501})
+		o.Expect(ok).To(o.BeFalse())
+	})
+})
+
+var _ = g.Describe("RawErrPredicate", func() {
+	g.Describe("Not", func() {
+		g.It("should negate the predicate", func() {
+			pred := errmatch.Matches(func(err error) bool {
+				return errors.Is(err, errTestError)
+			})
+
+			_, ok := pred.Not().Test(errTestError)
+			o.Expect(ok).To(o.BeFalse())
+
+			_, ok = pred.Not().Test(context.Canceled)
+			o.Expect(ok).To(o.BeTrue())
+		})
+	})
+})
+
+type HTTPError struct {
+	Status int
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("http error: %d", e.Status)
+}
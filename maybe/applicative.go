@@ -0,0 +1,77 @@
+package maybe
+
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip - returns Some pair of both values if both Maybes are defined, None otherwise.
+func Zip[A, B any](a *Maybe[A], b *Maybe[B]) *Maybe[Pair[A, B]] {
+	return Map2(a, b, func(first A, second B) Pair[A, B] {
+		return Pair[A, B]{First: first, Second: second}
+	})
+}
+
+// Map2 - maps values of both Maybes if all of them are defined, returns None otherwise.
+func Map2[A, B, R any](a *Maybe[A], b *Maybe[B], fn func(A, B) R) *Maybe[R] {
+	if a.IsEmpty() || b.IsEmpty() {
+		return None[R]()
+	}
+
+	return Some(fn(a.value, b.value))
+}
+
+// Map3 - maps values of all 3 Maybes if all of them are defined, returns None otherwise.
+func Map3[A, B, C, R any](a *Maybe[A], b *Maybe[B], c *Maybe[C], fn func(A, B, C) R) *Maybe[R] {
+	if a.IsEmpty() || b.IsEmpty() || c.IsEmpty() {
+		return None[R]()
+	}
+
+	return Some(fn(a.value, b.value, c.value))
+}
+
+// Map4 - maps values of all 4 Maybes if all of them are defined, returns None otherwise.
+func Map4[A, B, C, D, R any](
+	a *Maybe[A], b *Maybe[B], c *Maybe[C], d *Maybe[D],
+	fn func(A, B, C, D) R,
+) *Maybe[R] {
+	if a.IsEmpty() || b.IsEmpty() || c.IsEmpty() || d.IsEmpty() {
+		return None[R]()
+	}
+
+	return Some(fn(a.value, b.value, c.value, d.value))
+}
+
+// Map5 - maps values of all 5 Maybes if all of them are defined, returns None otherwise.
+func Map5[A, B, C, D, E, R any](
+	a *Maybe[A], b *Maybe[B], c *Maybe[C], d *Maybe[D], e *Maybe[E],
+	fn func(A, B, C, D, E) R,
+) *Maybe[R] {
+	if a.IsEmpty() || b.IsEmpty() || c.IsEmpty() || d.IsEmpty() || e.IsEmpty() {
+		return None[R]()
+	}
+
+	return Some(fn(a.value, b.value, c.value, d.value, e.value))
+}
+
+// Sequence - returns Some with all the values if every Maybe is defined, None otherwise.
+func Sequence[T any](mbs []*Maybe[T]) *Maybe[[]T] {
+	return Traverse(mbs, func(mb *Maybe[T]) *Maybe[T] {
+		return mb
+	})
+}
+
+// Traverse - maps all the values and returns Some with all the results if every result is defined, None otherwise.
+// Stops mapping at the first empty result.
+func Traverse[T, U any](values []T, fn func(T) *Maybe[U]) *Maybe[[]U] {
+	res := make([]U, 0, len(values))
+	for _, value := range values {
+		mb := fn(value)
+		if mb.IsEmpty() {
+			return None[[]U]()
+		}
+		res = append(res, mb.value)
+	}
+
+	return Some(res)
+}
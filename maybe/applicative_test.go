@@ -0,0 +1,166 @@
+package maybe_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_Zip(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`both values present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Zip(maybe.Some(1), maybe.Some("one"))
+
+		assert.Equal(t, maybe.Pair[int, string]{First: 1, Second: "one"}, res.OrZero())
+	})
+
+	t.Run(`first value missing`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Zip(maybe.None[int](), maybe.Some("one"))
+
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`second value missing`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Zip(maybe.Some(1), maybe.None[string]())
+
+		assert.True(t, res.IsEmpty())
+	})
+}
+
+func Test_MapN(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`all values present`, func(t *testing.T) {
+		t.Parallel()
+
+		res2 := maybe.Map2(maybe.Some(1), maybe.Some(2), func(a, b int) int {
+			return a + b
+		})
+		res3 := maybe.Map3(maybe.Some(1), maybe.Some(2), maybe.Some(3), func(a, b, c int) int {
+			return a + b + c
+		})
+		res4 := maybe.Map4(maybe.Some(1), maybe.Some(2), maybe.Some(3), maybe.Some(4), func(a, b, c, d int) int {
+			return a + b + c + d
+		})
+		res5 := maybe.Map5(
+			maybe.Some(1), maybe.Some(2), maybe.Some(3), maybe.Some(4), maybe.Some("5"),
+			func(a, b, c, d int, e string) string {
+				return strconv.Itoa(a+b+c+d) + e
+			},
+		)
+
+		assert.Equal(t, 3, res2.OrZero())
+		assert.Equal(t, 6, res3.OrZero())
+		assert.Equal(t, 10, res4.OrZero())
+		assert.Equal(t, "105", res5.OrZero())
+	})
+
+	t.Run(`some value missing`, func(t *testing.T) {
+		t.Parallel()
+
+		mapperEvaluated := false
+		sum := func(a, b, c, d, e int) int {
+			mapperEvaluated = true
+			return a + b + c + d + e
+		}
+
+		res2 := maybe.Map2(maybe.Some(1), maybe.None[int](), func(a, b int) int {
+			return sum(a, b, 0, 0, 0)
+		})
+		res3 := maybe.Map3(maybe.Some(1), maybe.Some(2), maybe.None[int](), func(a, b, c int) int {
+			return sum(a, b, c, 0, 0)
+		})
+		res4 := maybe.Map4(maybe.None[int](), maybe.Some(2), maybe.Some(3), maybe.Some(4), func(a, b, c, d int) int {
+			return sum(a, b, c, d, 0)
+		})
+		res5 := maybe.Map5(maybe.Some(1), maybe.Some(2), maybe.Some(3), maybe.Some(4), nil, sum)
+
+		assert.True(t, res2.IsEmpty())
+		assert.True(t, res3.IsEmpty())
+		assert.True(t, res4.IsEmpty())
+		assert.True(t, res5.IsEmpty())
+		assert.False(t, mapperEvaluated)
+	})
+}
+
+func Test_Traverse(t *testing.T) {
+	t.Parallel()
+
+	parse := func(s string) *maybe.Maybe[int] {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return maybe.None[int]()
+		}
+		return maybe.Some(i)
+	}
+
+	t.Run(`all results present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Traverse([]string{"1", "2", "3"}, parse)
+
+		assert.Equal(t, []int{1, 2, 3}, res.OrZero())
+	})
+
+	t.Run(`some result missing`, func(t *testing.T) {
+		t.Parallel()
+
+		evaluated := make([]string, 0)
+
+		res := maybe.Traverse([]string{"1", "two", "3"}, func(s string) *maybe.Maybe[int] {
+			evaluated = append(evaluated, s)
+			return parse(s)
+		})
+
+		assert.True(t, res.IsEmpty())
+		assert.Equal(t, []string{"1", "two"}, evaluated)
+	})
+
+	t.Run(`empty slice`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Traverse([]string{}, parse)
+
+		assert.True(t, res.IsDefined())
+		assert.Empty(t, res.OrZero())
+	})
+}
+
+func Test_Sequence(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`all values present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Sequence([]*maybe.Maybe[int]{maybe.Some(1), maybe.Some(2)})
+
+		assert.Equal(t, []int{1, 2}, res.OrZero())
+	})
+
+	t.Run(`some value missing`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Sequence([]*maybe.Maybe[int]{maybe.Some(1), maybe.None[int]()})
+
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`empty slice`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Sequence([]*maybe.Maybe[int]{})
+
+		assert.True(t, res.IsDefined())
+		assert.Empty(t, res.OrZero())
+	})
+}
@@ -0,0 +1,58 @@
+package maybe
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// Equal - tests if both Maybes are empty or both hold equal values.
+// Nil Maybe is equal to None.
+func Equal[T comparable](a, b *Maybe[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualFunc - tests if both Maybes are empty or both hold values equal according to eq.
+// Nil Maybe is equal to None.
+func EqualFunc[T, U any](a *Maybe[T], b *Maybe[U], eq func(T, U) bool) bool {
+	if a.IsEmpty() || b.IsEmpty() {
+		return a.IsEmpty() == b.IsEmpty()
+	}
+
+	return eq(a.value, b.value)
+}
+
+// Compare - compares two Maybes, None (and nil) sorts before any value.
+// Returns -1 if a is less than b, 0 if they are equal and +1 if a is greater than b.
+func Compare[T cmp.Ordered](a, b *Maybe[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// CompareFunc - compares two Maybes using cmpFn for values, None (and nil) sorts before any value.
+func CompareFunc[T, U any](a *Maybe[T], b *Maybe[U], cmpFn func(T, U) int) int {
+	switch {
+	case a.IsEmpty() && b.IsEmpty():
+		return 0
+	case a.IsEmpty():
+		return -1
+	case b.IsEmpty():
+		return 1
+	default:
+		return cmpFn(a.value, b.value)
+	}
+}
+
+// Equal - tests if both Maybes are empty or both hold equal values.
+// Values are compared using their Equal(T) bool method if present (e.g. time.Time) or reflect.DeepEqual otherwise.
+// Nil Maybe is equal to None. The method lets github.com/google/go-cmp compare *Maybe[T] values,
+// use fungotest.CmpOption to compare Maybe[T] values.
+func (m *Maybe[T]) Equal(other *Maybe[T]) bool {
+	return EqualFunc(m, other, equalValues[T])
+}
+
+func equalValues[T any](x, y T) bool {
+	if eq, ok := any(x).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(y)
+	}
+
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,107 @@
+package maybe_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_Equal(t *testing.T) {
+	t.Parallel()
+
+	var nilMaybe *maybe.Maybe[int]
+
+	assert.True(t, maybe.Equal(maybe.Some(1), maybe.Some(1)))
+	assert.True(t, maybe.Equal(maybe.None[int](), maybe.None[int]()))
+	assert.True(t, maybe.Equal(nilMaybe, maybe.None[int]()))
+	assert.True(t, maybe.Equal(maybe.Null[int](), maybe.None[int]()))
+	assert.False(t, maybe.Equal(maybe.Some(1), maybe.Some(2)))
+	assert.False(t, maybe.Equal(maybe.Some(0), maybe.None[int]()))
+	assert.False(t, maybe.Equal(nilMaybe, maybe.Some(0)))
+}
+
+func Test_EqualFunc(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, maybe.EqualFunc(maybe.Some("John"), maybe.Some("john"), strings.EqualFold))
+	assert.True(t, maybe.EqualFunc(maybe.None[string](), maybe.None[string](), strings.EqualFold))
+	assert.False(t, maybe.EqualFunc(maybe.Some("john"), maybe.None[string](), strings.EqualFold))
+}
+
+func Test_Compare(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`compare`, func(t *testing.T) {
+		t.Parallel()
+
+		var nilMaybe *maybe.Maybe[int]
+
+		assert.Equal(t, 0, maybe.Compare(maybe.Some(1), maybe.Some(1)))
+		assert.Equal(t, -1, maybe.Compare(maybe.Some(1), maybe.Some(2)))
+		assert.Equal(t, 1, maybe.Compare(maybe.Some(2), maybe.Some(1)))
+		assert.Equal(t, -1, maybe.Compare(maybe.None[int](), maybe.Some(-100)))
+		assert.Equal(t, 1, maybe.Compare(maybe.Some(-100), nilMaybe))
+		assert.Equal(t, 0, maybe.Compare(nilMaybe, maybe.None[int]()))
+	})
+
+	t.Run(`sort`, func(t *testing.T) {
+		t.Parallel()
+
+		values := []*maybe.Maybe[int]{maybe.Some(3), maybe.None[int](), maybe.Some(1), nil, maybe.Some(2)}
+
+		slices.SortStableFunc(values, maybe.Compare[int])
+		res := slices.CompactFunc(values, maybe.Equal[int])
+
+		assert.Len(t, res, 4)
+		assert.True(t, res[0].IsEmpty())
+		assert.Equal(t, []int{1, 2, 3}, []int{res[1].OrZero(), res[2].OrZero(), res[3].OrZero()})
+	})
+
+	t.Run(`compare func`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 0, maybe.CompareFunc(maybe.Some("John"), maybe.Some("john"), func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		}))
+	})
+}
+
+func Test_Maybe_Equal(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		Name    *maybe.Maybe[string]
+		Tags    *maybe.Maybe[[]string]
+		Created *maybe.Maybe[time.Time]
+	}
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run(`method`, func(t *testing.T) {
+		t.Parallel()
+
+		var nilMaybe *maybe.Maybe[[]string]
+
+		assert.True(t, maybe.Some([]string{"a"}).Equal(maybe.Some([]string{"a"})))
+		assert.True(t, nilMaybe.Equal(maybe.None[[]string]()))
+		assert.False(t, maybe.Some([]string{"a"}).Equal(maybe.Some([]string{"b"})))
+		assert.True(t, maybe.Some(created).Equal(maybe.Some(created.In(time.FixedZone("CET", 3600)))))
+	})
+
+	t.Run(`go-cmp`, func(t *testing.T) {
+		t.Parallel()
+
+		a := record{Name: maybe.Some("john"), Tags: nil, Created: maybe.Some(created)}
+		b := record{Name: maybe.Some("john"), Tags: maybe.None[[]string](), Created: maybe.Some(created)}
+		c := record{Name: maybe.Some("jane"), Tags: maybe.None[[]string](), Created: maybe.Some(created)}
+
+		assert.Empty(t, cmp.Diff(a, b))
+		assert.NotEmpty(t, cmp.Diff(a, c))
+	})
+}
@@ -0,0 +1,160 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+type encodingUser struct {
+	XMLName xml.Name            `json:"-" xml:"user" yaml:"-"`
+	Name    maybe.Maybe[string] `json:"name" xml:"name" yaml:"name"`
+	Age     maybe.Maybe[int]    `json:"age" xml:"age" yaml:"age"`
+}
+
+// Test_Maybe_DecodingAcrossFormats - empty strings and absent fields are decoded as None in every format,
+// only JSON decodes null as Null and rejects empty strings for types not decoded from JSON strings.
+func Test_Maybe_DecodingAcrossFormats(t *testing.T) {
+	t.Parallel()
+
+	type state int
+	const (
+		none state = iota
+		null
+		some
+	)
+
+	decoders := map[string]func(data string, user *encodingUser) error{
+		"json": func(data string, user *encodingUser) error { return json.Unmarshal([]byte(data), user) },
+		"xml":  func(data string, user *encodingUser) error { return xml.Unmarshal([]byte(data), user) },
+		"yaml": func(data string, user *encodingUser) error { return yaml.Unmarshal([]byte(data), user) },
+		"text": func(data string, user *encodingUser) error {
+			if err := user.Name.UnmarshalText([]byte(data)); err != nil {
+				return err
+			}
+			return user.Age.UnmarshalText([]byte(data))
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		inputs   map[string]string
+		expected state
+	}{
+		{
+			name: "absent",
+			inputs: map[string]string{
+				"json": `{}`,
+				"xml":  `<user></user>`,
+				"yaml": `{}`,
+			},
+			expected: none,
+		},
+		{
+			name: "empty string",
+			inputs: map[string]string{
+				"json": `{"name":""}`,
+				"xml":  `<user><name></name><age></age></user>`,
+				"yaml": "name: \"\"\nage: \"\"\n",
+				"text": "",
+			},
+			expected: none,
+		},
+		{
+			name: "null in json",
+			inputs: map[string]string{
+				"json": `{"name":null,"age":null}`,
+			},
+			expected: null,
+		},
+		{
+			name: "null in yaml",
+			inputs: map[string]string{
+				"yaml": "name: null\nage: ~\n",
+			},
+			expected: none,
+		},
+		{
+			name: "value",
+			inputs: map[string]string{
+				"json": `{"name":"7","age":7}`,
+				"xml":  `<user><name>7</name><age>7</age></user>`,
+				"yaml": "name: \"7\"\nage: 7\n",
+				"text": "7",
+			},
+			expected: some,
+		},
+	}
+
+	for _, tc := range testCases {
+		for format, input := range tc.inputs {
+			t.Run(tc.name+" "+format, func(t *testing.T) {
+				t.Parallel()
+
+				var user encodingUser
+				err := decoders[format](input, &user)
+
+				require.NoError(t, err)
+				switch tc.expected {
+				case none:
+					assert.True(t, user.Name.IsEmpty())
+					assert.False(t, user.Name.IsNull())
+					assert.True(t, user.Age.IsEmpty())
+					assert.False(t, user.Age.IsNull())
+				case null:
+					assert.True(t, user.Name.IsNull())
+					assert.True(t, user.Age.IsNull())
+				case some:
+					assert.Equal(t, "7", user.Name.OrZero())
+					assert.Equal(t, 7, user.Age.OrZero())
+				}
+			})
+		}
+	}
+}
+
+func Test_Maybe_JSONEmptyString(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`not string type`, func(t *testing.T) {
+		t.Parallel()
+
+		var user encodingUser
+		err := json.Unmarshal([]byte(`{"age":""}`), &user)
+
+		var typeErr *json.UnmarshalTypeError
+		require.ErrorAs(t, err, &typeErr)
+		assert.True(t, user.Age.IsEmpty())
+	})
+
+	t.Run(`text unmarshaler`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Maybe[time.Time]
+		err := json.Unmarshal([]byte(`""`), &res)
+
+		require.NoError(t, err)
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`round trip`, func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(encodingUser{Name: *maybe.Some(""), Age: *maybe.Some(0)})
+		require.NoError(t, err)
+
+		var user encodingUser
+		err = json.Unmarshal(data, &user)
+
+		require.NoError(t, err)
+		assert.True(t, user.Name.IsEmpty())
+		assert.Equal(t, 0, user.Age.OrElse(1))
+	})
+}
@@ -0,0 +1,22 @@
+package maybe
+
+import (
+	"fmt"
+	"os"
+)
+
+// Env - reads environment variable with given key, unset or empty variable results in None.
+// Variable value is parsed using parse, nil result is None (like in OfNillable).
+func Env[T any](key string, parse func(string) (T, error)) (*Maybe[T], error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return None[T](), nil
+	}
+
+	parsed, err := parse(value)
+	if err != nil {
+		return None[T](), fmt.Errorf("env %s: %w", key, err)
+	}
+
+	return OfNillable(parsed), nil
+}
@@ -0,0 +1,50 @@
+package maybe_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+//nolint:paralleltest // t.Setenv can't be used in parallel tests
+func Test_Env(t *testing.T) {
+	t.Run(`variable set`, func(t *testing.T) {
+		t.Setenv("FUNGO_TEST_PORT", "0")
+
+		res, err := maybe.Env("FUNGO_TEST_PORT", strconv.Atoi)
+
+		require.NoError(t, err)
+		assert.True(t, res.IsDefined())
+		assert.Equal(t, 0, res.OrElse(8080))
+	})
+
+	t.Run(`variable not set`, func(t *testing.T) {
+		res, err := maybe.Env("FUNGO_TEST_NOT_SET", strconv.Atoi)
+
+		require.NoError(t, err)
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`variable empty`, func(t *testing.T) {
+		t.Setenv("FUNGO_TEST_PORT", "")
+
+		res, err := maybe.Env("FUNGO_TEST_PORT", strconv.Atoi)
+
+		require.NoError(t, err)
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`invalid value`, func(t *testing.T) {
+		t.Setenv("FUNGO_TEST_PORT", "http")
+
+		res, err := maybe.Env("FUNGO_TEST_PORT", strconv.Atoi)
+
+		require.ErrorIs(t, err, strconv.ErrSyntax)
+		assert.ErrorContains(t, err, "env FUNGO_TEST_PORT")
+		assert.True(t, res.IsEmpty())
+	})
+}
@@ -0,0 +1,66 @@
+package maybe
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FlagVar - defines a flag with given name and usage in the flag set (flag.CommandLine if nil)
+// and returns a Maybe, which stays None unless the flag is given.
+// Flag values are parsed using parse (nil results in None, like in OfNillable),
+// the last one wins if the flag is given multiple times.
+func FlagVar[T any](fs *flag.FlagSet, name, usage string, parse func(string) (T, error)) *Maybe[T] {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	target := None[T]()
+	fs.Var(&flagValue[T]{target: target, parse: parse}, name, usage)
+
+	return target
+}
+
+// FlagString - defines a string flag, which stays None unless given.
+func FlagString(fs *flag.FlagSet, name, usage string) *Maybe[string] {
+	return FlagVar(fs, name, usage, func(value string) (string, error) {
+		return value, nil
+	})
+}
+
+// FlagInt - defines an int flag, which stays None unless given.
+func FlagInt(fs *flag.FlagSet, name, usage string) *Maybe[int] {
+	return FlagVar(fs, name, usage, strconv.Atoi)
+}
+
+// FlagDuration - defines a time.Duration flag, which stays None unless given.
+func FlagDuration(fs *flag.FlagSet, name, usage string) *Maybe[time.Duration] {
+	return FlagVar(fs, name, usage, time.ParseDuration)
+}
+
+// flagValue - flag.Value setting the target Maybe.
+type flagValue[T any] struct {
+	target *Maybe[T]
+	parse  func(string) (T, error)
+}
+
+// String - returns the flag value or an empty string for None, so that flag.PrintDefaults doesn't print the default.
+func (f *flagValue[T]) String() string {
+	if f.target.IsEmpty() {
+		return ""
+	}
+
+	return fmt.Sprint(f.target.value)
+}
+
+func (f *flagValue[T]) Set(value string) error {
+	parsed, err := f.parse(value)
+	if err != nil {
+		return err
+	}
+
+	*f.target = *OfNillable(parsed)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package maybe_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_Flag(t *testing.T) {
+	t.Parallel()
+
+	newFlagSet := func() *flag.FlagSet {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(&bytes.Buffer{})
+		return fs
+	}
+
+	t.Run(`flags given`, func(t *testing.T) {
+		t.Parallel()
+
+		fs := newFlagSet()
+		name := maybe.FlagString(fs, "name", "user name")
+		port := maybe.FlagInt(fs, "port", "port")
+		timeout := maybe.FlagDuration(fs, "timeout", "timeout")
+
+		err := fs.Parse([]string{"-name=", "-port", "0", "-timeout=5s"})
+
+		require.NoError(t, err)
+		assert.True(t, name.IsDefined())
+		assert.Equal(t, "", name.OrElse("default"))
+		assert.True(t, port.IsDefined())
+		assert.Equal(t, 0, port.OrElse(8080))
+		assert.Equal(t, 5*time.Second, timeout.OrZero())
+	})
+
+	t.Run(`flags not given`, func(t *testing.T) {
+		t.Parallel()
+
+		fs := newFlagSet()
+		name := maybe.FlagString(fs, "name", "user name")
+		port := maybe.FlagInt(fs, "port", "port")
+
+		err := fs.Parse([]string{})
+
+		require.NoError(t, err)
+		assert.True(t, name.IsEmpty())
+		assert.True(t, port.IsEmpty())
+	})
+
+	t.Run(`last value wins`, func(t *testing.T) {
+		t.Parallel()
+
+		fs := newFlagSet()
+		port := maybe.FlagInt(fs, "port", "port")
+
+		err := fs.Parse([]string{"-port=1", "-port=2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, port.OrZero())
+		assert.Equal(t, "2", fs.Lookup("port").Value.String())
+	})
+
+	t.Run(`invalid value`, func(t *testing.T) {
+		t.Parallel()
+
+		fs := newFlagSet()
+		port := maybe.FlagInt(fs, "port", "port")
+
+		err := fs.Parse([]string{"-port=http"})
+
+		require.Error(t, err)
+		assert.True(t, port.IsEmpty())
+	})
+
+	t.Run(`custom parse`, func(t *testing.T) {
+		t.Parallel()
+
+		fs := newFlagSet()
+		level := maybe.FlagVar(fs, "level", "log level", func(value string) (*string, error) {
+			if value == "default" {
+				return nil, nil //nolint:nilnil // default level is None
+			}
+			return &value, nil
+		})
+
+		require.NoError(t, fs.Parse([]string{"-level=default"}))
+		assert.True(t, level.IsEmpty())
+
+		require.NoError(t, fs.Parse([]string{"-level=debug"}))
+		assert.Equal(t, "debug", *level.OrZero())
+	})
+
+	t.Run(`no default printed`, func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		fs := newFlagSet()
+		fs.SetOutput(&out)
+		maybe.FlagInt(fs, "port", "port to listen on")
+
+		fs.PrintDefaults()
+
+		assert.Equal(t, "  -port value\n    \tport to listen on\n", out.String())
+	})
+}
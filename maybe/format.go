@@ -0,0 +1,71 @@
+package maybe
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+)
+
+const (
+	noneString     = "None"
+	redactedString = "<redacted>"
+)
+
+// Sensitive - marks types, which values must not be printed.
+// Maybe holding a Sensitive value is formatted and logged as Some(<redacted>).
+type Sensitive interface {
+	Sensitive()
+}
+
+// String - implements fmt.Stringer, returns Some(v) or None.
+//
+// Formatting methods (String, GoString, Format and LogValue) use value receivers, so that Maybe[T] values
+// (e.g. struct fields) are formatted and logged the same way as *Maybe[T] and slog never falls back
+// to MarshalText or MarshalJSON (which don't redact Sensitive values).
+// Go calls value receiver methods on nil pointers through wrappers dereferencing them, hence nil *Maybe[T]
+// is printed by fmt as <nil> and reported by slog as a LogValue panic, log Maybe values or non-nil pointers instead.
+func (m Maybe[T]) String() string {
+	return fmt.Sprintf("%v", m)
+}
+
+// GoString - implements fmt.GoStringer, returns Go syntax creating the Maybe, e.g. maybe.Some[int](42).
+func (m Maybe[T]) GoString() string {
+	typeName := reflect.TypeFor[T]().String()
+	switch {
+	case m.defined && isSensitive(m.value):
+		return fmt.Sprintf("maybe.Some[%s](%s)", typeName, redactedString)
+	case m.defined:
+		return fmt.Sprintf("maybe.Some[%s](%#v)", typeName, m.value)
+	case m.null:
+		return fmt.Sprintf("maybe.Null[%s]()", typeName)
+	default:
+		return fmt.Sprintf("maybe.None[%s]()", typeName)
+	}
+}
+
+// Format - implements fmt.Formatter, formats None as None and Some(v) as Some(v)
+// with v formatted using given verb and flags.
+// %#v uses GoString.
+func (m Maybe[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		_, _ = io.WriteString(f, m.GoString())
+	case !m.defined:
+		_, _ = io.WriteString(f, noneString)
+	case isSensitive(m.value):
+		_, _ = io.WriteString(f, "Some("+redactedString+")")
+	default:
+		_, _ = fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", m.value)
+	}
+}
+
+// LogValue - implements slog.LogValuer, logs Some(v) or None, Sensitive values are logged as Some(<redacted>).
+func (m Maybe[T]) LogValue() slog.Value {
+	return slog.StringValue(m.String())
+}
+
+func isSensitive(value any) bool {
+	_, ok := value.(Sensitive)
+	return ok
+}
@@ -0,0 +1,145 @@
+package maybe_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+type password string
+
+func (password) Sensitive() {}
+
+type formatUser struct {
+	Name     maybe.Maybe[string]
+	Nickname maybe.Maybe[string]
+	Age      *maybe.Maybe[int]
+}
+
+func Test_Maybe_Format(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		mb := maybe.Some(42)
+
+		assert.Equal(t, "Some(42)", mb.String())
+		assert.Equal(t, "Some(42)", fmt.Sprint(mb))
+		assert.Equal(t, "Some(42)", fmt.Sprintf("%v", mb))
+		assert.Equal(t, "Some(2a)", fmt.Sprintf("%x", mb))
+		assert.Equal(t, "Some(  42)", fmt.Sprintf("%4d", mb))
+		assert.Equal(t, "Some(+42)", fmt.Sprintf("%+d", mb))
+		assert.Equal(t, `Some("john")`, fmt.Sprintf("%q", maybe.Some("john")))
+		assert.Equal(t, "maybe.Some[int](42)", fmt.Sprintf("%#v", mb))
+		assert.Equal(t, `maybe.Some[string]("john")`, maybe.Some("john").GoString())
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		mb := maybe.None[int]()
+
+		assert.Equal(t, "None", mb.String())
+		assert.Equal(t, "None", fmt.Sprintf("%d", mb))
+		assert.Equal(t, "maybe.None[int]()", fmt.Sprintf("%#v", mb))
+		assert.Equal(t, "maybe.Null[int]()", fmt.Sprintf("%#v", maybe.Null[int]()))
+	})
+
+	t.Run(`struct fields`, func(t *testing.T) {
+		t.Parallel()
+
+		user := formatUser{Name: *maybe.Some("john"), Nickname: *maybe.Null[string](), Age: maybe.None[int]()}
+
+		assert.Equal(t, "{Some(john) None None}", fmt.Sprintf("%v", user))
+		assert.Equal(t, "{Name:Some(john) Nickname:None Age:None}", fmt.Sprintf("%+v", user))
+		assert.Equal(t, "&{Name:Some(john) Nickname:None Age:None}", fmt.Sprintf("%+v", &user))
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var mb *maybe.Maybe[int]
+
+		assert.Equal(t, "<nil>", fmt.Sprint(mb))
+	})
+
+	t.Run(`sensitive value`, func(t *testing.T) {
+		t.Parallel()
+
+		mb := maybe.Some(password("secret"))
+
+		assert.Equal(t, "Some(<redacted>)", mb.String())
+		assert.Equal(t, "Some(<redacted>)", fmt.Sprintf("%s", mb))
+		assert.Equal(t, "maybe.Some[maybe_test.password](<redacted>)", fmt.Sprintf("%#v", mb))
+		assert.Equal(t, "None", maybe.None[password]().String())
+	})
+}
+
+type logUser struct {
+	Name     string
+	Password maybe.Maybe[password]
+}
+
+func Test_Maybe_LogValue(t *testing.T) {
+	t.Parallel()
+
+	withoutTime := func(_ []string, attr slog.Attr) slog.Attr {
+		if attr.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+		return attr
+	}
+	user := logUser{Name: "john", Password: *maybe.Some(password("hunter2"))}
+
+	t.Run(`text handler`, func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: withoutTime}))
+
+		logger.Info("user",
+			slog.Any("age", maybe.Some(42)),
+			slog.Any("email", maybe.None[string]()),
+			slog.Any("password", maybe.Some(password("secret"))),
+			slog.Any("nickname", *maybe.Null[string]()),
+			slog.Any("pwd", user.Password),
+			slog.Any("user", formatUser{Name: *maybe.Some("john"), Nickname: *maybe.Null[string](), Age: maybe.Some(30)}),
+			slog.Any("login", user),
+			slog.Any("account", *maybe.Some(user)),
+		)
+
+		assert.Equal(t,
+			"level=INFO msg=user age=Some(42) email=None password=Some(<redacted>) nickname=None pwd=Some(<redacted>) "+
+				"user=\"{Name:Some(john) Nickname:None Age:Some(30)}\" "+
+				"login=\"{Name:john Password:Some(<redacted>)}\" "+
+				"account=\"Some({john Some(<redacted>)})\"\n",
+			buf.String(),
+		)
+	})
+
+	t.Run(`json handler`, func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: withoutTime}))
+
+		logger.Info("user",
+			slog.Any("age", *maybe.Some(42)),
+			slog.Any("email", maybe.None[string]()),
+			slog.Any("pwd", user.Password),
+			slog.Any("account", *maybe.Some(user)),
+		)
+
+		assert.JSONEq(t,
+			`{"level":"INFO","msg":"user","age":"Some(42)","email":"None","pwd":"Some(<redacted>)",`+
+				`"account":"Some({john Some(<redacted>)})"}`,
+			buf.String(),
+		)
+	})
+}
@@ -0,0 +1,39 @@
+package maybe
+
+// FromPtr - returns Some with the value pointed to, or None if the pointer is nil.
+func FromPtr[T any](ptr *T) *Maybe[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+
+	return Some(*ptr)
+}
+
+// FromMap - returns Some with the value stored in the map under the key, or None if there is no such key.
+func FromMap[K comparable, V any](m map[K]V, key K) *Maybe[V] {
+	value, ok := m[key]
+	return FromOk(value, ok)
+}
+
+// FromSlice - returns Some with the slice element at the index, or None if the index is out of range.
+func FromSlice[T any](values []T, idx int) *Maybe[T] {
+	if idx < 0 || idx >= len(values) {
+		return None[T]()
+	}
+
+	return Some(values[idx])
+}
+
+// FromOk - converts the comma-ok idiom result into Some(value) if ok, or None otherwise.
+func FromOk[T any](value T, ok bool) *Maybe[T] {
+	if !ok {
+		return None[T]()
+	}
+
+	return Some(value)
+}
+
+// FromErr - converts the value-error idiom result into Some(value) if err is nil, or None otherwise.
+func FromErr[T any](value T, err error) *Maybe[T] {
+	return FromOk(value, err == nil)
+}
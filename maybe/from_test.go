@@ -0,0 +1,137 @@
+package maybe_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_FromPtr(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`non-nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		value := 1
+
+		res := maybe.FromPtr(&value)
+
+		assert.Equal(t, 1, res.OrZero())
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.FromPtr[int](nil)
+
+		assert.True(t, res.IsEmpty())
+	})
+}
+
+func Test_FromMap(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]int{"one": 1, "zero": 0}
+
+	t.Run(`key present`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 1, maybe.FromMap(values, "one").OrZero())
+		assert.True(t, maybe.FromMap(values, "zero").IsDefined())
+	})
+
+	t.Run(`key absent`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, maybe.FromMap(values, "two").IsEmpty())
+	})
+
+	t.Run(`nil map`, func(t *testing.T) {
+		t.Parallel()
+
+		var nilMap map[string]int
+
+		assert.True(t, maybe.FromMap(nilMap, "one").IsEmpty())
+	})
+}
+
+func Test_FromSlice(t *testing.T) {
+	t.Parallel()
+
+	values := []int{1, 2}
+
+	t.Run(`index in range`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 1, maybe.FromSlice(values, 0).OrZero())
+		assert.Equal(t, 2, maybe.FromSlice(values, 1).OrZero())
+	})
+
+	t.Run(`index out of range`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, maybe.FromSlice(values, -1).IsEmpty())
+		assert.True(t, maybe.FromSlice(values, 2).IsEmpty())
+		assert.True(t, maybe.FromSlice[int](nil, 0).IsEmpty())
+	})
+}
+
+func Test_FromOk(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 1, maybe.FromOk(1, true).OrZero())
+	})
+
+	t.Run(`not ok`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, maybe.FromOk(1, false).IsEmpty())
+	})
+}
+
+func Test_FromErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`no error`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 1, maybe.FromErr(strconv.Atoi("1")).OrZero())
+	})
+
+	t.Run(`error`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, maybe.FromErr(strconv.Atoi("one")).IsEmpty())
+	})
+}
+
+// Benchmark_OfNillable_Ptr - measures the reflection based nil check, compare with Benchmark_FromPtr.
+func Benchmark_OfNillable_Ptr(b *testing.B) {
+	b.ReportAllocs()
+	value := 1
+	defined := 0
+	for range b.N {
+		if maybe.OfNillable(&value).IsDefined() {
+			defined++
+		}
+	}
+	assert.Equal(b, b.N, defined)
+}
+
+func Benchmark_FromPtr(b *testing.B) {
+	b.ReportAllocs()
+	value := 1
+	defined := 0
+	for range b.N {
+		if maybe.FromPtr(&value).IsDefined() {
+			defined++
+		}
+	}
+	assert.Equal(b, b.N, defined)
+}
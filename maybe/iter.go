@@ -0,0 +1,40 @@
+package maybe
+
+import "iter"
+
+// All - returns an iterator yielding the value if present, or nothing otherwise.
+func (m *Maybe[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if m.IsDefined() {
+			yield(m.value)
+		}
+	}
+}
+
+// Collect - collects values of all the defined Maybes from the sequence, skipping the empty ones.
+func Collect[T any](seq iter.Seq[*Maybe[T]]) []T {
+	res := make([]T, 0)
+	for mb := range seq {
+		if mb.IsDefined() {
+			res = append(res, mb.value)
+		}
+	}
+
+	return res
+}
+
+// First - returns the first element of the sequence or None if the sequence is empty.
+func First[T any](seq iter.Seq[T]) *Maybe[T] {
+	return Find(seq, func(T) bool { return true })
+}
+
+// Find - returns the first element of the sequence matching the predicate or None if no element matches.
+func Find[T any](seq iter.Seq[T], pred func(T) bool) *Maybe[T] {
+	for value := range seq {
+		if pred(value) {
+			return Some(value)
+		}
+	}
+
+	return None[T]()
+}
@@ -0,0 +1,111 @@
+package maybe_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_Maybe_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := slices.Collect(maybe.Some(1).All())
+
+		assert.Equal(t, []int{1}, res)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := slices.Collect(maybe.None[int]().All())
+
+		assert.Empty(t, res)
+	})
+
+	t.Run(`range over`, func(t *testing.T) {
+		t.Parallel()
+
+		sum := 0
+		for value := range maybe.Some(2).All() {
+			sum += value
+		}
+
+		assert.Equal(t, 2, sum)
+	})
+}
+
+func Test_Collect(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`mixed values`, func(t *testing.T) {
+		t.Parallel()
+
+		mbs := []*maybe.Maybe[int]{maybe.Some(1), maybe.None[int](), nil, maybe.Some(3)}
+
+		res := maybe.Collect(slices.Values(mbs))
+
+		assert.Equal(t, []int{1, 3}, res)
+	})
+
+	t.Run(`empty sequence`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Collect(slices.Values([]*maybe.Maybe[int]{}))
+
+		assert.NotNil(t, res)
+		assert.Empty(t, res)
+	})
+}
+
+func Test_First(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`non-empty sequence`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.First(slices.Values([]int{1, 2, 3}))
+
+		assert.Equal(t, 1, res.OrZero())
+	})
+
+	t.Run(`empty sequence`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.First(slices.Values([]int{}))
+
+		assert.True(t, res.IsEmpty())
+	})
+}
+
+func Test_Find(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`element found`, func(t *testing.T) {
+		t.Parallel()
+
+		evaluated := make([]int, 0)
+		res := maybe.Find(slices.Values([]int{1, 2, 3, 4}), func(i int) bool {
+			evaluated = append(evaluated, i)
+			return i%2 == 0
+		})
+
+		assert.Equal(t, 2, res.OrZero())
+		assert.Equal(t, []int{1, 2}, evaluated)
+	})
+
+	t.Run(`element not found`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Find(slices.Values([]int{1, 3}), func(i int) bool {
+			return i%2 == 0
+		})
+
+		assert.True(t, res.IsEmpty())
+	})
+}
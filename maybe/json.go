@@ -0,0 +1,107 @@
+package maybe
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+const (
+	jsonNull        = "null"
+	jsonEmptyString = `""`
+)
+
+// Null - creates an empty Maybe, which is explicitly set to null.
+// It behaves like None, but allows distinguishing between absent and null values (e.g. in PATCH requests).
+// Decoding JSON null into Null works for Maybe[T] fields only, encoding/json sets *Maybe[T] fields to nil instead.
+func Null[T any]() *Maybe[T] {
+	return &Maybe[T]{null: true}
+}
+
+// IsNull - tests if the Maybe is empty and was explicitly set to null (either using Null or decoded from null).
+func (m *Maybe[T]) IsNull() bool {
+	return m != nil && !m.defined && m.null
+}
+
+// IsZero - tests if the Maybe is empty and was not explicitly set to null.
+// Used by encoding/json to drop Maybe[T] and *Maybe[T] fields tagged with `omitzero`, while Null is encoded as null.
+// `omitempty` doesn't work for Maybe[T] fields, since encoding/json never treats structs as empty,
+// and drops *Maybe[T] fields only when the pointer is nil.
+// Value receiver lets yaml.v3 use it for Maybe[T] fields tagged with `omitempty`,
+// encoders check nil *Maybe[T] themselves, use IsEmpty and IsNull to test possibly nil pointers directly.
+func (m Maybe[T]) IsZero() bool {
+	return !m.defined && !m.null
+}
+
+// MarshalJSON - encodes None and Null as null and Some(v) as v.
+// Value receiver lets encoding/json use it for non-addressable values too.
+func (m Maybe[T]) MarshalJSON() ([]byte, error) {
+	if !m.defined {
+		return []byte(jsonNull), nil
+	}
+
+	data, err := json.Marshal(m.value)
+	if err != nil {
+		return nil, fmt.Errorf("marshal maybe: %w", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON - decodes null as Null, empty string as None and any other value v as Some(v).
+// Fields absent in the JSON document are left untouched, hence remain None.
+// Empty strings and absent fields are decoded the same way in every supported format (JSON, text, XML and YAML),
+// null is decoded as Null only by JSON (YAML decodes it as None).
+// Empty string is decoded as None only if T is decoded from JSON strings (string kinds and encoding.TextUnmarshaler),
+// for other types (e.g. numbers) it's an invalid value, hence Some("") is encoded as "" and decoded back as None.
+//
+// Three-state decoding (absent, null, value) requires Maybe[T] fields.
+// For *Maybe[T] fields encoding/json handles null itself by setting the pointer to nil,
+// so absent and null fields can't be told apart (both are nil, hence None).
+func (m *Maybe[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		*m = Maybe[T]{null: true}
+		return nil
+	}
+	if string(data) == jsonEmptyString && isJSONStringType[T]() {
+		*m = Maybe[T]{}
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("unmarshal maybe: %w", err)
+	}
+
+	*m = Maybe[T]{defined: true, value: value}
+
+	return nil
+}
+
+// MarshalJSON - encodes None as null and Some(v) as v, the same way as Maybe.
+func (o Opt[T]) MarshalJSON() ([]byte, error) {
+	return Maybe[T]{defined: o.defined, value: o.value}.MarshalJSON()
+}
+
+// UnmarshalJSON - decodes JSON the same way as Maybe, except null is decoded as None, since Opt has no Null state.
+func (o *Opt[T]) UnmarshalJSON(data []byte) error {
+	var m Maybe[T]
+	if err := m.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	*o = Opt[T]{defined: m.defined, value: m.value}
+
+	return nil
+}
+
+// isJSONStringType - tests if T is decoded from JSON strings, i.e. it's a string kind or encoding.TextUnmarshaler.
+func isJSONStringType[T any]() bool {
+	var value T
+	if _, ok := any(&value).(encoding.TextUnmarshaler); ok {
+		return true
+	}
+
+	return reflect.TypeFor[T]().Kind() == reflect.String
+}
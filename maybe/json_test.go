@@ -0,0 +1,228 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+type patchUserRequest struct {
+	Name     maybe.Maybe[string]  `json:"name,omitzero"`
+	Nickname *maybe.Maybe[string] `json:"nickname,omitzero"`
+	Age      maybe.Maybe[int]     `json:"age"`
+}
+
+func Test_Maybe_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := json.Marshal(maybe.Some("john"))
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `"john"`, string(res))
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := json.Marshal(maybe.None[string]())
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `null`, string(res))
+	})
+
+	t.Run(`null value`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := json.Marshal(maybe.Null[string]())
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `null`, string(res))
+	})
+
+	t.Run(`empty struct fields are omitted`, func(t *testing.T) {
+		t.Parallel()
+
+		req := patchUserRequest{
+			Name:     *maybe.Some("john"),
+			Nickname: maybe.None[string](),
+			Age:      *maybe.None[int](),
+		}
+
+		res, err := json.Marshal(req)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"john","age":null}`, string(res))
+	})
+
+	t.Run(`null struct fields are not omitted`, func(t *testing.T) {
+		t.Parallel()
+
+		req := patchUserRequest{
+			Name:     *maybe.Null[string](),
+			Nickname: maybe.Null[string](),
+			Age:      *maybe.Some(30),
+		}
+
+		res, err := json.Marshal(req)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":null,"nickname":null,"age":30}`, string(res))
+	})
+}
+
+func Test_Maybe_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`value present`, func(t *testing.T) {
+		t.Parallel()
+
+		var req patchUserRequest
+		err := json.Unmarshal([]byte(`{"name":"john","nickname":"johnny","age":30}`), &req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "john", req.Name.OrZero())
+		assert.Equal(t, "johnny", req.Nickname.OrZero())
+		assert.Equal(t, 30, req.Age.OrZero())
+	})
+
+	t.Run(`explicit null`, func(t *testing.T) {
+		t.Parallel()
+
+		var req patchUserRequest
+		err := json.Unmarshal([]byte(`{"name":null,"age":null}`), &req)
+
+		require.NoError(t, err)
+		assert.True(t, req.Name.IsEmpty())
+		assert.True(t, req.Name.IsNull())
+		assert.True(t, req.Age.IsEmpty())
+		assert.True(t, req.Age.IsNull())
+	})
+
+	t.Run(`explicit null pointer field`, func(t *testing.T) {
+		t.Parallel()
+
+		req := patchUserRequest{Nickname: maybe.Some("johnny")}
+		err := json.Unmarshal([]byte(`{"nickname":null}`), &req)
+
+		require.NoError(t, err)
+		// encoding/json sets pointers to nil for null without calling UnmarshalJSON,
+		// so null can't be told apart from an absent field
+		assert.Nil(t, req.Nickname)
+		assert.True(t, req.Nickname.IsEmpty())
+		assert.False(t, req.Nickname.IsNull())
+	})
+
+	t.Run(`absent field`, func(t *testing.T) {
+		t.Parallel()
+
+		var req patchUserRequest
+		err := json.Unmarshal([]byte(`{}`), &req)
+
+		require.NoError(t, err)
+		assert.True(t, req.Name.IsEmpty())
+		assert.False(t, req.Name.IsNull())
+		assert.True(t, req.Nickname.IsEmpty())
+		assert.False(t, req.Nickname.IsNull())
+	})
+
+	t.Run(`invalid value type`, func(t *testing.T) {
+		t.Parallel()
+
+		var req patchUserRequest
+		err := json.Unmarshal([]byte(`{"age":"thirty"}`), &req)
+
+		var typeErr *json.UnmarshalTypeError
+		require.ErrorAs(t, err, &typeErr)
+		assert.True(t, req.Age.IsEmpty())
+	})
+}
+
+func Test_Maybe_IsNull(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`null`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Null[int]()
+
+		assert.True(t, res.IsNull())
+		assert.True(t, res.IsEmpty())
+		assert.False(t, res.IsZero())
+	})
+
+	t.Run(`none`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.None[int]()
+
+		assert.False(t, res.IsNull())
+		assert.True(t, res.IsZero())
+	})
+
+	t.Run(`some`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Some(0)
+
+		assert.False(t, res.IsNull())
+		assert.False(t, res.IsZero())
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var res *maybe.Maybe[int]
+
+		assert.False(t, res.IsNull())
+		assert.True(t, res.IsEmpty())
+	})
+}
+
+func Test_Opt_JSON(t *testing.T) {
+	t.Parallel()
+
+	type optUserRequest struct {
+		Name     maybe.Opt[string] `json:"name"`
+		Nickname maybe.Opt[string] `json:"nickname,omitzero"`
+		Age      maybe.Opt[int]    `json:"age"`
+	}
+
+	t.Run(`marshal`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := json.Marshal(optUserRequest{Name: maybe.SomeOpt("john")})
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"john","age":null}`, string(res))
+	})
+
+	t.Run(`unmarshal`, func(t *testing.T) {
+		t.Parallel()
+
+		var req optUserRequest
+		err := json.Unmarshal([]byte(`{"name":"john","nickname":null,"age":30}`), &req)
+
+		require.NoError(t, err)
+		assert.Equal(t, maybe.SomeOpt("john"), req.Name)
+		assert.True(t, req.Nickname.IsEmpty())
+		assert.Equal(t, maybe.SomeOpt(30), req.Age)
+	})
+
+	t.Run(`invalid value type`, func(t *testing.T) {
+		t.Parallel()
+
+		var req optUserRequest
+		err := json.Unmarshal([]byte(`{"age":"thirty"}`), &req)
+
+		var typeErr *json.UnmarshalTypeError
+		require.ErrorAs(t, err, &typeErr)
+		assert.True(t, req.Age.IsEmpty())
+	})
+}
@@ -0,0 +1,100 @@
+package maybe
+
+import "sync"
+
+// Supplier - function supplying a value on demand.
+type Supplier[T any] func() T
+
+// Memoize - returns a Supplier, which calls fn at most once and returns its result on every call.
+// It is safe for concurrent use, if fn panics, the returned Supplier panics with the same value on every call.
+func Memoize[T any](fn Supplier[T]) Supplier[T] {
+	return sync.OnceValue(fn)
+}
+
+// LazyMaybe - Maybe, which value is supplied on demand.
+// The supplier runs at most once, when the value is needed for the first time (e.g. by IsDefined, Get or OrElse).
+// Map, Filter and FlatMap don't run the supplier, they return a new LazyMaybe instead.
+// LazyMaybe is safe for concurrent use.
+type LazyMaybe[T any] struct {
+	supplier Supplier[*Maybe[T]]
+}
+
+// Lazy - creates a LazyMaybe, which runs the supplier at most once, when the value is needed for the first time.
+// Nil supplier and nil returned by the supplier are treated as None.
+func Lazy[T any](supplier func() *Maybe[T]) *LazyMaybe[T] {
+	if supplier == nil {
+		return &LazyMaybe[T]{}
+	}
+
+	return &LazyMaybe[T]{supplier: Memoize(supplier)}
+}
+
+// Force - runs the supplier (unless it has already run) and returns the supplied Maybe.
+func (l *LazyMaybe[T]) Force() *Maybe[T] {
+	if l == nil || l.supplier == nil {
+		return None[T]()
+	}
+	if mb := l.supplier(); mb != nil {
+		return mb
+	}
+
+	return None[T]()
+}
+
+func (l *LazyMaybe[T]) IsDefined() bool {
+	return l.Force().IsDefined()
+}
+
+func (l *LazyMaybe[T]) IsEmpty() bool {
+	return l.Force().IsEmpty()
+}
+
+func (l *LazyMaybe[T]) Get() (T, error) {
+	return l.Force().Get()
+}
+
+func (l *LazyMaybe[T]) OrZero() T {
+	return l.Force().OrZero()
+}
+
+func (l *LazyMaybe[T]) OrElse(other T) T {
+	return l.Force().OrElse(other)
+}
+
+func (l *LazyMaybe[T]) OrElseGet(other func() T) T {
+	return l.Force().OrElseGet(other)
+}
+
+func (l *LazyMaybe[T]) OrElseTryGet(other func() (T, error)) (T, error) {
+	return l.Force().OrElseTryGet(other)
+}
+
+func (l *LazyMaybe[T]) Filter(pred func(T) bool) *LazyMaybe[T] {
+	return Lazy(func() *Maybe[T] {
+		return l.Force().Filter(pred)
+	})
+}
+
+func (l *LazyMaybe[T]) Map(fn func(T) T) *LazyMaybe[T] {
+	return Lazy(func() *Maybe[T] {
+		return l.Force().Map(fn)
+	})
+}
+
+func (l *LazyMaybe[T]) FlatMap(fn func(T) *Maybe[T]) *LazyMaybe[T] {
+	return Lazy(func() *Maybe[T] {
+		return l.Force().FlatMap(fn)
+	})
+}
+
+func MapLazy[T, U any](l *LazyMaybe[T], fn func(T) U) *LazyMaybe[U] {
+	return Lazy(func() *Maybe[U] {
+		return Map(l.Force(), fn)
+	})
+}
+
+func FlatMapLazy[T, U any](l *LazyMaybe[T], fn func(T) *Maybe[U]) *LazyMaybe[U] {
+	return Lazy(func() *Maybe[U] {
+		return FlatMap(l.Force(), fn)
+	})
+}
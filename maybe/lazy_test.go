@@ -0,0 +1,129 @@
+package maybe_test
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_Memoize(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	supplier := maybe.Memoize(func() int {
+		calls.Add(1)
+		return 42
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 42, supplier())
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func Test_Lazy(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`supplier not run until value needed`, func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		lazy := maybe.Lazy(func() *maybe.Maybe[int] {
+			calls.Add(1)
+			return maybe.Some(1)
+		})
+
+		mapped := maybe.MapLazy(lazy.Map(func(v int) int { return v + 1 }).
+			Filter(func(v int) bool { return v > 1 }).
+			FlatMap(func(v int) *maybe.Maybe[int] { return maybe.Some(v * 10) }),
+			strconv.Itoa,
+		)
+
+		assert.Equal(t, int32(0), calls.Load())
+		assert.Equal(t, "20", mapped.OrElse("none"))
+		assert.Equal(t, 1, lazy.OrZero())
+		assert.True(t, lazy.IsDefined())
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run(`supplier run once when used concurrently`, func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		lazy := maybe.Lazy(func() *maybe.Maybe[int] {
+			calls.Add(1)
+			return maybe.Some(1)
+		})
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := lazy.Get()
+				assert.NoError(t, err)
+				assert.Equal(t, 1, res)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		lazy := maybe.Lazy(maybe.None[int])
+
+		res, err := lazy.Get()
+
+		assert.Zero(t, res)
+		assert.Equal(t, maybe.ErrEmptyMaybe, err)
+		assert.True(t, lazy.IsEmpty())
+		assert.Equal(t, 2, lazy.OrElse(2))
+		assert.Equal(t, 2, lazy.OrElseGet(func() int { return 2 }))
+		assert.True(t, lazy.Filter(func(int) bool { return true }).IsEmpty())
+		assert.True(t, maybe.FlatMapLazy(lazy, func(v int) *maybe.Maybe[string] {
+			return maybe.Some(strconv.Itoa(v))
+		}).IsEmpty())
+	})
+
+	t.Run(`nil supplied`, func(t *testing.T) {
+		t.Parallel()
+
+		lazy := maybe.Lazy(func() *maybe.Maybe[int] { return nil })
+
+		assert.True(t, lazy.IsEmpty())
+		assert.True(t, lazy.Force().IsEmpty())
+	})
+
+	t.Run(`nil supplier`, func(t *testing.T) {
+		t.Parallel()
+
+		lazy := maybe.Lazy[int](nil)
+
+		assert.False(t, lazy.IsDefined())
+		assert.Equal(t, 2, lazy.Map(func(v int) int { return v + 1 }).OrElse(2))
+	})
+
+	t.Run(`nil lazy`, func(t *testing.T) {
+		t.Parallel()
+
+		var lazy *maybe.LazyMaybe[int]
+
+		assert.True(t, lazy.IsEmpty())
+		assert.Equal(t, 2, lazy.OrElse(2))
+	})
+}
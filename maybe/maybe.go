@@ -2,6 +2,7 @@ package maybe
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 )
 
@@ -9,6 +10,7 @@ var ErrEmptyMaybe = errors.New("empty maybe")
 
 type Maybe[T any] struct {
 	defined bool
+	null    bool
 	value   T
 }
 
@@ -20,7 +22,7 @@ func (m *Maybe[T]) IsDefined() bool {
 }
 
 func (m *Maybe[T]) IsEmpty() bool {
-	return isNil(m) || !m.defined
+	return m == nil || !m.defined
 }
 
 func (m *Maybe[T]) Get() (T, error) {
@@ -65,6 +67,57 @@ func (m *Maybe[T]) OrElseTryGet(other func() (T, error)) (T, error) {
 	return m.value, nil
 }
 
+// OrElseErr - returns the value or given error (e.g. domain specific not found error) if empty.
+func (m *Maybe[T]) OrElseErr(err error) (T, error) {
+	if m.IsEmpty() {
+		var zero T
+		return zero, err
+	}
+
+	return m.value, nil
+}
+
+// OrPanic - returns the value or panics with given message (wrapping ErrEmptyMaybe) if empty.
+func (m *Maybe[T]) OrPanic(msg string) T {
+	if m.IsEmpty() {
+		panic(fmt.Errorf("%s: %w", msg, ErrEmptyMaybe))
+	}
+
+	return m.value
+}
+
+// Or - returns this Maybe if defined, other otherwise.
+func (m *Maybe[T]) Or(other *Maybe[T]) *Maybe[T] {
+	if m.IsEmpty() {
+		return other
+	}
+
+	return m
+}
+
+// IfPresent - invokes fn with the value if present.
+func (m *Maybe[T]) IfPresent(fn func(T)) {
+	if m.IsDefined() {
+		fn(m.value)
+	}
+}
+
+// IfPresentOrElse - invokes fn with the value if present, emptyFn otherwise.
+func (m *Maybe[T]) IfPresentOrElse(fn func(T), emptyFn func()) {
+	if m.IsDefined() {
+		fn(m.value)
+		return
+	}
+
+	emptyFn()
+}
+
+// Peek - invokes fn with the value if present and returns the same Maybe, to be used in call chains.
+func (m *Maybe[T]) Peek(fn func(T)) *Maybe[T] {
+	m.IfPresent(fn)
+	return m
+}
+
 func (m *Maybe[T]) Filter(pred func(T) bool) *Maybe[T] {
 	if m.IsEmpty() || !pred(m.OrZero()) {
 		return None[T]()
@@ -94,6 +147,9 @@ func Some[T any](value T) *Maybe[T] {
 	return &Maybe[T]{defined: true, value: value}
 }
 
+// OfNillable - returns None for nil values (pointers, maps, slices, funcs, chans and interfaces) or Some otherwise.
+// Nil check uses reflection on every call, hence in tight loops prefer the reflection-free constructors
+// (FromPtr, FromMap, FromSlice, FromOk and FromErr) or Some for the types, which are never nil.
 func OfNillable[T any](value T) *Maybe[T] {
 	if isNil(value) {
 		return None[T]()
@@ -1,6 +1,7 @@
 package maybe_test
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"testing"
@@ -777,6 +778,170 @@ func Test_FlatMap_Receiver_None(t *testing.T) {
 	})
 }
 
+func Test_Maybe_OrElseErr(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.Some(1).OrElseErr(errNotFound)
+
+		assert.Equal(t, 1, res)
+		assert.NoError(t, err)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.None[int]().OrElseErr(errNotFound)
+
+		assert.Zero(t, res)
+		assert.Equal(t, errNotFound, err)
+	})
+}
+
+func Test_Maybe_OrPanic(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Some(1).OrPanic("value required")
+
+		assert.Equal(t, 1, res)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.PanicsWithError(t, "value required: empty maybe", func() {
+			maybe.None[int]().OrPanic("value required")
+		})
+	})
+}
+
+func Test_Maybe_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Some(1).Or(maybe.Some(2))
+
+		assert.Equal(t, 1, res.OrZero())
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.None[int]().Or(maybe.Some(2))
+
+		assert.Equal(t, 2, res.OrZero())
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var mb *maybe.Maybe[int]
+
+		res := mb.Or(maybe.Some(2))
+
+		assert.Equal(t, 2, res.OrZero())
+	})
+}
+
+func Test_Maybe_IfPresent(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		received := 0
+
+		maybe.Some(1).IfPresent(func(i int) {
+			received = i
+		})
+
+		assert.Equal(t, 1, received)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		fnEvaluated := false
+
+		maybe.None[int]().IfPresent(func(_ int) {
+			fnEvaluated = true
+		})
+
+		assert.False(t, fnEvaluated)
+	})
+}
+
+func Test_Maybe_IfPresentOrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		received := 0
+		emptyFnEvaluated := false
+
+		maybe.Some(1).IfPresentOrElse(
+			func(i int) { received = i },
+			func() { emptyFnEvaluated = true },
+		)
+
+		assert.Equal(t, 1, received)
+		assert.False(t, emptyFnEvaluated)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		fnEvaluated := false
+		emptyFnEvaluated := false
+
+		maybe.None[int]().IfPresentOrElse(
+			func(_ int) { fnEvaluated = true },
+			func() { emptyFnEvaluated = true },
+		)
+
+		assert.False(t, fnEvaluated)
+		assert.True(t, emptyFnEvaluated)
+	})
+}
+
+func Test_Maybe_Peek(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		peeked := 0
+
+		res := maybe.Some(1).
+			Peek(func(i int) { peeked = i }).
+			Map(func(i int) int { return i * 2 })
+
+		assert.Equal(t, 1, peeked)
+		assert.Equal(t, 2, res.OrZero())
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		fnEvaluated := false
+
+		res := maybe.None[int]().Peek(func(_ int) { fnEvaluated = true })
+
+		assert.True(t, res.IsEmpty())
+		assert.False(t, fnEvaluated)
+	})
+}
+
 type maybeTestInterface interface {
 	Do()
 }
@@ -0,0 +1,141 @@
+package maybe
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	ErrMergeNilBase   = errors.New("merge base is nil")
+	ErrMergeNotStruct = errors.New("merge target is not a struct")
+)
+
+// Layer - named overlay merged by MergeLayers.
+type Layer[S any] struct {
+	Name  string
+	Value *S
+}
+
+// Merge - copies every Maybe field (either *Maybe[T] or Maybe[T]), which is defined in the overlay, over the base.
+// Nested structs (and pointers to structs) are merged recursively, other fields are left untouched.
+// Nil overlay is treated as an empty one.
+// Returns paths of the fields set by the overlay (e.g. Server.Port), fields of embedded structs are reported without prefix.
+func Merge[S any](base, overlay *S) ([]string, error) {
+	if base == nil {
+		return nil, ErrMergeNilBase
+	}
+	baseValue := reflect.ValueOf(base).Elem()
+	if baseValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s", ErrMergeNotStruct, baseValue.Type())
+	}
+	if overlay == nil {
+		return []string{}, nil
+	}
+
+	return mergeStruct(baseValue, reflect.ValueOf(overlay).Elem(), "", []string{}), nil
+}
+
+// MergeLayers - merges layers over the base in given order (the last layer wins)
+// and returns the name of the layer, which has set each of the merged fields.
+func MergeLayers[S any](base *S, layers ...Layer[S]) (map[string]string, error) {
+	sources := make(map[string]string)
+	for _, layer := range layers {
+		paths, err := Merge(base, layer.Value)
+		if err != nil {
+			return nil, fmt.Errorf("merge layer %s: %w", layer.Name, err)
+		}
+		for _, path := range paths {
+			sources[path] = layer.Name
+		}
+	}
+
+	return sources, nil
+}
+
+var sealedMaybeType = reflect.TypeFor[interface{ sealedMaybe() }]()
+
+func mergeStruct(base, overlay reflect.Value, prefix string, paths []string) []string {
+	for i := range base.NumField() {
+		field := base.Type().Field(i)
+		embedded := field.Anonymous && field.Type.Kind() == reflect.Struct
+		if !field.IsExported() && !embedded {
+			continue
+		}
+
+		path := prefix + field.Name
+		if embedded {
+			// like in encoding/json, fields of embedded structs are promoted, even if the struct type is unexported
+			path = strings.TrimSuffix(prefix, ".")
+		}
+
+		paths = mergeField(base.Field(i), overlay.Field(i), path, paths)
+	}
+
+	return paths
+}
+
+func mergeField(base, overlay reflect.Value, path string, paths []string) []string {
+	fieldType := base.Type()
+	switch {
+	case fieldType.Kind() == reflect.Pointer && fieldType.Implements(sealedMaybeType):
+		if overlay.IsNil() || !isDefined(overlay) {
+			return paths
+		}
+		copied := reflect.New(fieldType.Elem())
+		copied.Elem().Set(overlay.Elem())
+		base.Set(copied)
+
+		return append(paths, path)
+	case reflect.PointerTo(fieldType).Implements(sealedMaybeType):
+		if !isDefined(overlay) {
+			return paths
+		}
+		base.Set(overlay)
+
+		return append(paths, path)
+	case fieldType.Kind() == reflect.Struct:
+		return mergeStruct(base, overlay, pathPrefix(path), paths)
+	case fieldType.Kind() == reflect.Pointer && fieldType.Elem().Kind() == reflect.Struct:
+		return mergeStructPtr(base, overlay, path, paths)
+	default:
+		return paths
+	}
+}
+
+func mergeStructPtr(base, overlay reflect.Value, path string, paths []string) []string {
+	if overlay.IsNil() {
+		return paths
+	}
+	if !base.IsNil() {
+		return mergeStruct(base.Elem(), overlay.Elem(), pathPrefix(path), paths)
+	}
+
+	// nil base struct is set only if the overlay sets any of its fields
+	merged := reflect.New(base.Type().Elem())
+	mergedPaths := mergeStruct(merged.Elem(), overlay.Elem(), pathPrefix(path), paths)
+	if len(mergedPaths) > len(paths) {
+		base.Set(merged)
+	}
+
+	return mergedPaths
+}
+
+// isDefined - tests if the Maybe held by value (either addressable Maybe[T] or *Maybe[T]) is defined.
+func isDefined(value reflect.Value) bool {
+	if value.Kind() != reflect.Pointer {
+		value = value.Addr()
+	}
+
+	//nolint:forcetypeassert // checked with sealedMaybeType
+	return value.Interface().(interface{ IsDefined() bool }).IsDefined()
+}
+
+func pathPrefix(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	return path + "."
+}
@@ -0,0 +1,144 @@
+package maybe_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+type mergeTLS struct {
+	Enabled *maybe.Maybe[bool]
+}
+
+type mergeServer struct {
+	Host *maybe.Maybe[string]
+	Port *maybe.Maybe[int]
+	TLS  *mergeTLS
+}
+
+type mergeLogging struct {
+	Level maybe.Maybe[string]
+}
+
+type mergeConfig struct {
+	mergeLogging
+
+	Server  mergeServer
+	Timeout *maybe.Maybe[time.Duration]
+	Name    string
+	secret  *maybe.Maybe[string]
+}
+
+func Test_Merge(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`defined fields copied`, func(t *testing.T) {
+		t.Parallel()
+
+		base := mergeConfig{
+			Server:  mergeServer{Host: maybe.Some("localhost"), Port: maybe.Some(80)},
+			Timeout: maybe.Some(time.Second),
+			Name:    "base",
+		}
+		overlay := mergeConfig{
+			mergeLogging: mergeLogging{Level: *maybe.Some("debug")},
+			Server:       mergeServer{Port: maybe.Some(8080), Host: maybe.None[string](), TLS: &mergeTLS{Enabled: maybe.Some(true)}},
+			Name:         "overlay",
+			secret:       maybe.Some("secret"),
+		}
+
+		paths, err := maybe.Merge(&base, &overlay)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Level", "Server.Port", "Server.TLS.Enabled"}, paths)
+		assert.Equal(t, "debug", base.Level.OrZero())
+		assert.Equal(t, "localhost", base.Server.Host.OrZero())
+		assert.Equal(t, 8080, base.Server.Port.OrZero())
+		assert.True(t, base.Server.TLS.Enabled.OrZero())
+		assert.Equal(t, time.Second, base.Timeout.OrZero())
+		assert.Equal(t, "base", base.Name)
+		assert.Nil(t, base.secret)
+	})
+
+	t.Run(`copied fields not shared with overlay`, func(t *testing.T) {
+		t.Parallel()
+
+		base := mergeConfig{}
+		overlay := mergeConfig{Timeout: maybe.Some(time.Second)}
+
+		_, err := maybe.Merge(&base, &overlay)
+		require.NoError(t, err)
+		*overlay.Timeout = *maybe.Some(time.Minute)
+
+		assert.Equal(t, time.Second, base.Timeout.OrZero())
+	})
+
+	t.Run(`nested struct without defined fields not allocated`, func(t *testing.T) {
+		t.Parallel()
+
+		base := mergeConfig{}
+		overlay := mergeConfig{Server: mergeServer{TLS: &mergeTLS{Enabled: maybe.None[bool]()}}}
+
+		paths, err := maybe.Merge(&base, &overlay)
+
+		require.NoError(t, err)
+		assert.Empty(t, paths)
+		assert.Nil(t, base.Server.TLS)
+	})
+
+	t.Run(`nil overlay`, func(t *testing.T) {
+		t.Parallel()
+
+		base := mergeConfig{Name: "base"}
+
+		paths, err := maybe.Merge(&base, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, paths)
+		assert.Equal(t, mergeConfig{Name: "base"}, base)
+	})
+
+	t.Run(`invalid base`, func(t *testing.T) {
+		t.Parallel()
+
+		_, err := maybe.Merge[mergeConfig](nil, &mergeConfig{})
+		require.ErrorIs(t, err, maybe.ErrMergeNilBase)
+
+		value := 1
+		_, err = maybe.Merge(&value, &value)
+		require.ErrorIs(t, err, maybe.ErrMergeNotStruct)
+	})
+}
+
+func Test_MergeLayers(t *testing.T) {
+	t.Parallel()
+
+	base := mergeConfig{}
+	defaults := mergeConfig{
+		Server:  mergeServer{Host: maybe.Some("localhost"), Port: maybe.Some(80)},
+		Timeout: maybe.Some(time.Second),
+	}
+	file := mergeConfig{Server: mergeServer{Port: maybe.Some(8080)}}
+	env := mergeConfig{Timeout: maybe.Some(time.Minute)}
+
+	sources, err := maybe.MergeLayers(&base,
+		maybe.Layer[mergeConfig]{Name: "defaults", Value: &defaults},
+		maybe.Layer[mergeConfig]{Name: "file", Value: &file},
+		maybe.Layer[mergeConfig]{Name: "env", Value: &env},
+		maybe.Layer[mergeConfig]{Name: "flags"},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Server.Host": "defaults",
+		"Server.Port": "file",
+		"Timeout":     "env",
+	}, sources)
+	assert.Equal(t, "localhost", base.Server.Host.OrZero())
+	assert.Equal(t, 8080, base.Server.Port.OrZero())
+	assert.Equal(t, time.Minute, base.Timeout.OrZero())
+}
@@ -0,0 +1,197 @@
+package maybe
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Opt - value semantics variant of Maybe, which is passed by value and doesn't require heap allocations.
+// It provides the same API as Maybe, including JSON encoding and database/sql support (without the Null state,
+// null is decoded as None). Text, XML and YAML encoding and formatting are provided by Maybe only,
+// use ToMaybe and Maybe.ToOpt to convert between the two.
+type Opt[T any] struct {
+	defined bool
+	value   T
+}
+
+func (o Opt[T]) IsDefined() bool {
+	return o.defined
+}
+
+func (o Opt[T]) IsEmpty() bool {
+	return !o.defined
+}
+
+func (o Opt[T]) Get() (T, error) {
+	if !o.defined {
+		var zero T
+		return zero, ErrEmptyMaybe
+	}
+
+	return o.value, nil
+}
+
+func (o Opt[T]) OrZero() T {
+	return o.value
+}
+
+func (o Opt[T]) OrElse(other T) T {
+	if !o.defined {
+		return other
+	}
+
+	return o.value
+}
+
+func (o Opt[T]) OrElseGet(other func() T) T {
+	if !o.defined {
+		return other()
+	}
+
+	return o.value
+}
+
+func (o Opt[T]) OrElseTryGet(other func() (T, error)) (T, error) {
+	if !o.defined {
+		return other()
+	}
+
+	return o.value, nil
+}
+
+func (o Opt[T]) OrElseErr(err error) (T, error) {
+	if !o.defined {
+		var zero T
+		return zero, err
+	}
+
+	return o.value, nil
+}
+
+func (o Opt[T]) OrPanic(msg string) T {
+	if !o.defined {
+		panic(fmt.Errorf("%s: %w", msg, ErrEmptyMaybe))
+	}
+
+	return o.value
+}
+
+func (o Opt[T]) Or(other Opt[T]) Opt[T] {
+	if !o.defined {
+		return other
+	}
+
+	return o
+}
+
+func (o Opt[T]) IfPresent(fn func(T)) {
+	if o.defined {
+		fn(o.value)
+	}
+}
+
+func (o Opt[T]) IfPresentOrElse(fn func(T), emptyFn func()) {
+	if o.defined {
+		fn(o.value)
+		return
+	}
+
+	emptyFn()
+}
+
+func (o Opt[T]) Peek(fn func(T)) Opt[T] {
+	o.IfPresent(fn)
+	return o
+}
+
+func (o Opt[T]) Filter(pred func(T) bool) Opt[T] {
+	if !o.defined || !pred(o.value) {
+		return NoneOpt[T]()
+	}
+	return o
+}
+
+func (o Opt[T]) Map(fn func(T) T) Opt[T] {
+	if !o.defined {
+		return o
+	}
+	return SomeOpt(fn(o.value))
+}
+
+func (o Opt[T]) FlatMap(fn func(T) Opt[T]) Opt[T] {
+	if !o.defined {
+		return o
+	}
+	return fn(o.value)
+}
+
+func (o Opt[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.defined {
+			yield(o.value)
+		}
+	}
+}
+
+// ToMaybe - converts the Opt into a Maybe.
+func (o Opt[T]) ToMaybe() *Maybe[T] {
+	if !o.defined {
+		return None[T]()
+	}
+
+	return Some(o.value)
+}
+
+// ToOpt - converts the Maybe into an Opt, nil Maybe is converted into an empty Opt.
+func (m *Maybe[T]) ToOpt() Opt[T] {
+	if m.IsEmpty() {
+		return NoneOpt[T]()
+	}
+
+	return SomeOpt(m.value)
+}
+
+func NoneOpt[T any]() Opt[T] {
+	return Opt[T]{}
+}
+
+func SomeOpt[T any](value T) Opt[T] {
+	return Opt[T]{defined: true, value: value}
+}
+
+func MapOpt[T, U any](o Opt[T], fn func(T) U) Opt[U] {
+	if !o.defined {
+		return NoneOpt[U]()
+	}
+
+	return SomeOpt(fn(o.value))
+}
+
+func TryMapOpt[T, U any](o Opt[T], fn func(T) (U, error)) (Opt[U], error) {
+	if !o.defined {
+		return NoneOpt[U](), nil
+	}
+
+	val, err := fn(o.value)
+	if err != nil {
+		return NoneOpt[U](), err
+	}
+
+	return SomeOpt(val), nil
+}
+
+func FlatMapOpt[T, U any](o Opt[T], fn func(T) Opt[U]) Opt[U] {
+	if !o.defined {
+		return NoneOpt[U]()
+	}
+
+	return fn(o.value)
+}
+
+func TryFlatMapOpt[T, U any](o Opt[T], fn func(T) (Opt[U], error)) (Opt[U], error) {
+	if !o.defined {
+		return NoneOpt[U](), nil
+	}
+
+	return fn(o.value)
+}
@@ -0,0 +1,263 @@
+package maybe_test
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_Opt_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.SomeOpt(1).Get()
+
+		assert.Equal(t, 1, res)
+		assert.NoError(t, err)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.NoneOpt[int]().Get()
+
+		assert.Zero(t, res)
+		assert.Equal(t, maybe.ErrEmptyMaybe, err)
+	})
+
+	t.Run(`zero value`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Opt[int]
+
+		assert.True(t, res.IsEmpty())
+		assert.False(t, res.IsDefined())
+	})
+}
+
+func Test_Opt_OrElse(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		opt := maybe.SomeOpt(1)
+
+		assert.Equal(t, 1, opt.OrZero())
+		assert.Equal(t, 1, opt.OrElse(2))
+		assert.Equal(t, 1, opt.OrElseGet(func() int { return 2 }))
+		assert.Equal(t, 1, opt.OrPanic("value required"))
+		assert.Equal(t, 1, opt.Or(maybe.SomeOpt(2)).OrZero())
+
+		res, err := opt.OrElseTryGet(func() (int, error) { return 2, nil })
+		assert.Equal(t, 1, res)
+		assert.NoError(t, err)
+
+		res, err = opt.OrElseErr(errNotFound)
+		assert.Equal(t, 1, res)
+		assert.NoError(t, err)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		opt := maybe.NoneOpt[int]()
+
+		assert.Zero(t, opt.OrZero())
+		assert.Equal(t, 2, opt.OrElse(2))
+		assert.Equal(t, 2, opt.OrElseGet(func() int { return 2 }))
+		assert.Equal(t, 2, opt.Or(maybe.SomeOpt(2)).OrZero())
+		assert.PanicsWithError(t, "value required: empty maybe", func() {
+			opt.OrPanic("value required")
+		})
+
+		res, err := opt.OrElseTryGet(func() (int, error) { return 2, nil })
+		assert.Equal(t, 2, res)
+		assert.NoError(t, err)
+
+		res, err = opt.OrElseErr(errNotFound)
+		assert.Zero(t, res)
+		assert.Equal(t, errNotFound, err)
+	})
+}
+
+func Test_Opt_SideEffects(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		received := make([]int, 0)
+		emptyFnEvaluated := false
+		opt := maybe.SomeOpt(1)
+
+		opt.IfPresent(func(i int) { received = append(received, i) })
+		opt.IfPresentOrElse(func(i int) { received = append(received, i) }, func() { emptyFnEvaluated = true })
+		opt.Peek(func(i int) { received = append(received, i) })
+
+		assert.Equal(t, []int{1, 1, 1}, received)
+		assert.False(t, emptyFnEvaluated)
+		assert.Equal(t, []int{1}, slices.Collect(opt.All()))
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		fnEvaluated := false
+		emptyFnEvaluated := false
+		opt := maybe.NoneOpt[int]()
+
+		opt.IfPresent(func(_ int) { fnEvaluated = true })
+		opt.IfPresentOrElse(func(_ int) { fnEvaluated = true }, func() { emptyFnEvaluated = true })
+		opt.Peek(func(_ int) { fnEvaluated = true })
+
+		assert.False(t, fnEvaluated)
+		assert.True(t, emptyFnEvaluated)
+		assert.Empty(t, slices.Collect(opt.All()))
+	})
+}
+
+func Test_Opt_Transformations(t *testing.T) {
+	t.Parallel()
+
+	double := func(i int) int { return i * 2 }
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		opt := maybe.SomeOpt(1)
+
+		assert.Equal(t, 2, opt.Map(double).OrZero())
+		assert.Equal(t, 2, opt.FlatMap(func(i int) maybe.Opt[int] { return maybe.SomeOpt(double(i)) }).OrZero())
+		assert.True(t, opt.Filter(isEven).IsEmpty())
+		assert.True(t, opt.Map(double).Filter(isEven).IsDefined())
+		assert.Equal(t, "1", maybe.MapOpt(opt, strconv.Itoa).OrZero())
+		assert.Equal(t, "1", maybe.FlatMapOpt(opt, func(i int) maybe.Opt[string] {
+			return maybe.SomeOpt(strconv.Itoa(i))
+		}).OrZero())
+
+		res, err := maybe.TryMapOpt(maybe.SomeOpt("1"), strconv.Atoi)
+		assert.Equal(t, 1, res.OrZero())
+		assert.NoError(t, err)
+
+		res, err = maybe.TryMapOpt(maybe.SomeOpt("one"), strconv.Atoi)
+		assert.True(t, res.IsEmpty())
+		assert.Error(t, err)
+
+		res, err = maybe.TryFlatMapOpt(maybe.SomeOpt("1"), func(s string) (maybe.Opt[int], error) {
+			i, err := strconv.Atoi(s)
+			return maybe.SomeOpt(i), err
+		})
+		assert.Equal(t, 1, res.OrZero())
+		assert.NoError(t, err)
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		opt := maybe.NoneOpt[int]()
+
+		assert.True(t, opt.Map(double).IsEmpty())
+		assert.True(t, opt.FlatMap(func(i int) maybe.Opt[int] { return maybe.SomeOpt(i) }).IsEmpty())
+		assert.True(t, opt.Filter(isEven).IsEmpty())
+		assert.True(t, maybe.MapOpt(opt, strconv.Itoa).IsEmpty())
+		assert.True(t, maybe.FlatMapOpt(opt, func(i int) maybe.Opt[string] {
+			return maybe.SomeOpt(strconv.Itoa(i))
+		}).IsEmpty())
+
+		res, err := maybe.TryMapOpt(maybe.NoneOpt[string](), strconv.Atoi)
+		assert.True(t, res.IsEmpty())
+		assert.NoError(t, err)
+
+		res, err = maybe.TryFlatMapOpt(maybe.NoneOpt[string](), func(_ string) (maybe.Opt[int], error) {
+			return maybe.SomeOpt(1), nil
+		})
+		assert.True(t, res.IsEmpty())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_Opt_Conversions(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`some value present`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 1, maybe.SomeOpt(1).ToMaybe().OrZero())
+		assert.Equal(t, 1, maybe.Some(1).ToOpt().OrZero())
+	})
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		var nilMaybe *maybe.Maybe[int]
+
+		assert.True(t, maybe.NoneOpt[int]().ToMaybe().IsEmpty())
+		assert.True(t, maybe.None[int]().ToOpt().IsEmpty())
+		assert.True(t, nilMaybe.ToOpt().IsEmpty())
+	})
+}
+
+func Benchmark_Maybe_Pipeline(b *testing.B) {
+	b.ReportAllocs()
+	sum := 0
+	for i := range b.N {
+		sum += maybe.Some(i).
+			Filter(func(v int) bool { return v%2 == 1 }).
+			Map(func(v int) int { return v * 2 }).
+			OrElse(1)
+	}
+	assert.Positive(b, sum)
+}
+
+func Benchmark_Opt_Pipeline(b *testing.B) {
+	b.ReportAllocs()
+	sum := 0
+	for i := range b.N {
+		sum += maybe.SomeOpt(i).
+			Filter(func(v int) bool { return v%2 == 1 }).
+			Map(func(v int) int { return v * 2 }).
+			OrElse(1)
+	}
+	assert.Positive(b, sum)
+}
+
+func Benchmark_Maybe_Return(b *testing.B) {
+	b.ReportAllocs()
+	lookup := func(i int) *maybe.Maybe[int] {
+		if i%2 == 0 {
+			return maybe.None[int]()
+		}
+		return maybe.Some(i)
+	}
+	results := make([]*maybe.Maybe[int], 0, b.N)
+	for i := range b.N {
+		results = append(results, lookup(i))
+	}
+	assert.Len(b, results, b.N)
+}
+
+func Benchmark_Opt_Return(b *testing.B) {
+	b.ReportAllocs()
+	lookup := func(i int) maybe.Opt[int] {
+		if i%2 == 0 {
+			return maybe.NoneOpt[int]()
+		}
+		return maybe.SomeOpt(i)
+	}
+	results := make([]maybe.Opt[int], 0, b.N)
+	for i := range b.N {
+		results = append(results, lookup(i))
+	}
+	assert.Len(b, results, b.N)
+}
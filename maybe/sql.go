@@ -0,0 +1,57 @@
+package maybe
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+// Scan - implements sql.Scanner, SQL NULL is scanned as None and any other value as Some.
+// Supports all the conversions supported by the database/sql package for the type T.
+func (m *Maybe[T]) Scan(src any) error {
+	if src == nil {
+		*m = Maybe[T]{}
+		return nil
+	}
+
+	var value sql.Null[T]
+	if err := value.Scan(src); err != nil {
+		return fmt.Errorf("scan maybe: %w", err)
+	}
+
+	*m = Maybe[T]{defined: true, value: value.V}
+
+	return nil
+}
+
+// Value - implements driver.Valuer, None is stored as SQL NULL and Some(v) as v converted to driver.Value.
+// Value receiver lets database/sql use it for both Maybe values and pointers.
+func (m Maybe[T]) Value() (driver.Value, error) {
+	if !m.defined {
+		return nil, nil //nolint:nilnil // SQL NULL is represented by nil driver.Value
+	}
+
+	value, err := driver.DefaultParameterConverter.ConvertValue(m.value)
+	if err != nil {
+		return nil, fmt.Errorf("value maybe: %w", err)
+	}
+
+	return value, nil
+}
+
+// Scan - implements sql.Scanner, scans the value the same way as Maybe.
+func (o *Opt[T]) Scan(src any) error {
+	var m Maybe[T]
+	if err := m.Scan(src); err != nil {
+		return err
+	}
+
+	*o = Opt[T]{defined: m.defined, value: m.value}
+
+	return nil
+}
+
+// Value - implements driver.Valuer, converts the value the same way as Maybe.
+func (o Opt[T]) Value() (driver.Value, error) {
+	return Maybe[T]{defined: o.defined, value: o.value}.Value()
+}
@@ -0,0 +1,227 @@
+package maybe_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+var (
+	_ sql.Scanner   = (*maybe.Maybe[string])(nil)
+	_ driver.Valuer = (*maybe.Maybe[string])(nil)
+	_ sql.Scanner   = (*maybe.Opt[string])(nil)
+	_ driver.Valuer = maybe.Opt[string]{}
+)
+
+type maybeTestUnsupported struct {
+	Value string
+}
+
+func Test_Maybe_Scan(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`null`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Some("john")
+		err := res.Scan(nil)
+
+		require.NoError(t, err)
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`string`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Maybe[string]
+		err := res.Scan("john")
+
+		require.NoError(t, err)
+		assert.Equal(t, "john", res.OrZero())
+	})
+
+	t.Run(`int from int64`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Maybe[int]
+		err := res.Scan(int64(42))
+
+		require.NoError(t, err)
+		assert.Equal(t, 42, res.OrZero())
+	})
+
+	t.Run(`int from bytes`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Maybe[int]
+		err := res.Scan([]byte("42"))
+
+		require.NoError(t, err)
+		assert.Equal(t, 42, res.OrZero())
+	})
+
+	t.Run(`time`, func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		var res maybe.Maybe[time.Time]
+		err := res.Scan(now)
+
+		require.NoError(t, err)
+		assert.Equal(t, now, res.OrZero())
+	})
+
+	t.Run(`bytes`, func(t *testing.T) {
+		t.Parallel()
+
+		src := []byte("data")
+		var res maybe.Maybe[[]byte]
+		err := res.Scan(src)
+		src[0] = 'x'
+
+		require.NoError(t, err)
+		assert.Equal(t, []byte("data"), res.OrZero())
+	})
+
+	t.Run(`invalid conversion`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Maybe[int]
+		err := res.Scan("forty two")
+
+		require.Error(t, err)
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`unsupported type`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Maybe[maybeTestUnsupported]
+		err := res.Scan("john")
+
+		require.ErrorContains(t, err, "unsupported Scan")
+		assert.True(t, res.IsEmpty())
+	})
+}
+
+func Test_Maybe_Value(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.None[string]().Value()
+
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var mb *maybe.Maybe[string]
+		res, err := driver.DefaultParameterConverter.ConvertValue(mb)
+
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run(`string`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.Some("john").Value()
+
+		require.NoError(t, err)
+		assert.Equal(t, "john", res)
+	})
+
+	t.Run(`int`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.Some(42).Value()
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), res)
+	})
+
+	t.Run(`time`, func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		res, err := maybe.Some(now).Value()
+
+		require.NoError(t, err)
+		assert.Equal(t, now, res)
+	})
+
+	t.Run(`unsupported type`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.Some(maybeTestUnsupported{Value: "john"}).Value()
+
+		require.ErrorContains(t, err, "unsupported type")
+		assert.Nil(t, res)
+	})
+}
+
+func Test_Opt_Scan(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`null`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.SomeOpt("john")
+		err := res.Scan(nil)
+
+		require.NoError(t, err)
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`int from int64`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Opt[int]
+		err := res.Scan(int64(42))
+
+		require.NoError(t, err)
+		assert.Equal(t, 42, res.OrZero())
+	})
+
+	t.Run(`invalid conversion`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.SomeOpt(1)
+		err := res.Scan("forty two")
+
+		require.Error(t, err)
+		assert.Equal(t, 1, res.OrZero())
+	})
+}
+
+func Test_Opt_Value(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.NoneOpt[string]().Value()
+
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run(`int`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := driver.DefaultParameterConverter.ConvertValue(maybe.SomeOpt(42))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), res)
+	})
+}
@@ -0,0 +1,141 @@
+package maybe
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var ErrUnsupportedTextType = errors.New("unsupported text type")
+
+// MarshalText - encodes None as empty text and Some(v) as text representation of v.
+// Supports encoding.TextMarshaler implementations, time.Duration and basic types (strings, booleans and numbers).
+func (m Maybe[T]) MarshalText() ([]byte, error) {
+	if !m.defined {
+		return []byte{}, nil
+	}
+
+	return marshalText(m.value)
+}
+
+// UnmarshalText - decodes empty text as None and any other text as Some.
+// Supports encoding.TextUnmarshaler implementations, time.Duration and basic types (strings, booleans and numbers).
+func (m *Maybe[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*m = Maybe[T]{}
+		return nil
+	}
+
+	value, err := unmarshalText[T](string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = Maybe[T]{defined: true, value: value}
+
+	return nil
+}
+
+func marshalText[T any](value T) ([]byte, error) {
+	switch typed := any(value).(type) {
+	case encoding.TextMarshaler:
+		text, err := typed.MarshalText()
+		if err != nil {
+			return nil, fmt.Errorf("marshal maybe text: %w", err)
+		}
+		return text, nil
+	case time.Duration:
+		return []byte(typed.String()), nil
+	}
+
+	rv := reflect.ValueOf(value)
+	//nolint:exhaustive // only basic kinds are supported and default handles the rest
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedTextType, value)
+	}
+}
+
+func unmarshalText[T any](text string) (T, error) {
+	var value T
+	switch typed := any(&value).(type) {
+	case encoding.TextUnmarshaler:
+		if err := typed.UnmarshalText([]byte(text)); err != nil {
+			return value, fmt.Errorf("unmarshal maybe text: %w", err)
+		}
+		return value, nil
+	case *time.Duration:
+		duration, err := time.ParseDuration(text)
+		if err != nil {
+			return value, fmt.Errorf("unmarshal maybe text: %w", err)
+		}
+		*typed = duration
+		return value, nil
+	}
+
+	rv := reflect.ValueOf(&value).Elem()
+	var err error
+	//nolint:exhaustive // only basic kinds are supported and default handles the rest
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(text)
+	case reflect.Bool:
+		var parsed bool
+		parsed, err = strconv.ParseBool(text)
+		rv.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var parsed int64
+		parsed, err = strconv.ParseInt(text, 10, rv.Type().Bits())
+		rv.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var parsed uint64
+		parsed, err = strconv.ParseUint(text, 10, rv.Type().Bits())
+		rv.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		var parsed float64
+		parsed, err = strconv.ParseFloat(text, rv.Type().Bits())
+		rv.SetFloat(parsed)
+	default:
+		return value, fmt.Errorf("%w: %T", ErrUnsupportedTextType, value)
+	}
+
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("unmarshal maybe text: %w", err)
+	}
+
+	return value, nil
+}
+
+// isTextType - tests if T is supported by marshalText and unmarshalText functions.
+func isTextType[T any]() bool {
+	var value T
+	switch any(&value).(type) {
+	case encoding.TextUnmarshaler, *time.Duration:
+		return true
+	}
+
+	//nolint:exhaustive // only basic kinds are supported and default handles the rest
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,113 @@
+package maybe_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+func Test_Maybe_MarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`no value present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := maybe.None[int]().MarshalText()
+
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run(`basic types`, func(t *testing.T) {
+		t.Parallel()
+
+		assertText := func(expected string, mb interface{ MarshalText() ([]byte, error) }) {
+			t.Helper()
+			res, err := mb.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, expected, string(res))
+		}
+
+		assertText("john", maybe.Some("john"))
+		assertText("true", maybe.Some(true))
+		assertText("-42", maybe.Some(int8(-42)))
+		assertText("42", maybe.Some(uint(42)))
+		assertText("1.5", maybe.Some(1.5))
+		assertText("1m30s", maybe.Some(90*time.Second))
+		assertText("2024-01-02T03:04:05Z", maybe.Some(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+	})
+
+	t.Run(`unsupported type`, func(t *testing.T) {
+		t.Parallel()
+
+		_, err := maybe.Some([]int{1}).MarshalText()
+
+		require.ErrorIs(t, err, maybe.ErrUnsupportedTextType)
+	})
+}
+
+func Test_Maybe_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`empty text`, func(t *testing.T) {
+		t.Parallel()
+
+		res := maybe.Some("john")
+		err := res.UnmarshalText([]byte{})
+
+		require.NoError(t, err)
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`basic types`, func(t *testing.T) {
+		t.Parallel()
+
+		var str maybe.Maybe[string]
+		var boolean maybe.Maybe[bool]
+		var integer maybe.Maybe[int16]
+		var unsigned maybe.Maybe[uint64]
+		var float maybe.Maybe[float32]
+		var duration maybe.Maybe[time.Duration]
+		var date maybe.Maybe[time.Time]
+
+		require.NoError(t, str.UnmarshalText([]byte("john")))
+		require.NoError(t, boolean.UnmarshalText([]byte("true")))
+		require.NoError(t, integer.UnmarshalText([]byte("-42")))
+		require.NoError(t, unsigned.UnmarshalText([]byte("42")))
+		require.NoError(t, float.UnmarshalText([]byte("1.5")))
+		require.NoError(t, duration.UnmarshalText([]byte("1m30s")))
+		require.NoError(t, date.UnmarshalText([]byte("2024-01-02T03:04:05Z")))
+
+		assert.Equal(t, "john", str.OrZero())
+		assert.True(t, boolean.OrZero())
+		assert.Equal(t, int16(-42), integer.OrZero())
+		assert.Equal(t, uint64(42), unsigned.OrZero())
+		assert.InDelta(t, float32(1.5), float.OrZero(), 0)
+		assert.Equal(t, 90*time.Second, duration.OrZero())
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), date.OrZero())
+	})
+
+	t.Run(`invalid text`, func(t *testing.T) {
+		t.Parallel()
+
+		var integer maybe.Maybe[int8]
+		err := integer.UnmarshalText([]byte("300"))
+
+		require.Error(t, err)
+		assert.True(t, integer.IsEmpty())
+	})
+
+	t.Run(`unsupported type`, func(t *testing.T) {
+		t.Parallel()
+
+		var res maybe.Maybe[[]int]
+		err := res.UnmarshalText([]byte("1"))
+
+		require.ErrorIs(t, err, maybe.ErrUnsupportedTextType)
+		assert.True(t, res.IsEmpty())
+	})
+}
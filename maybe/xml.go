@@ -0,0 +1,74 @@
+package maybe
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// MarshalXML - omits the element for None and encodes Some(v) as the element holding v.
+func (m Maybe[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !m.defined {
+		return nil
+	}
+
+	var value any = m.value
+	if isTextType[T]() {
+		text, err := marshalText(m.value)
+		if err != nil {
+			return err
+		}
+		value = string(text)
+	}
+
+	if err := e.EncodeElement(value, start); err != nil {
+		return fmt.Errorf("marshal maybe xml: %w", err)
+	}
+
+	return nil
+}
+
+// UnmarshalXML - decodes the element as Some, elements with empty text content are decoded as None.
+// Elements absent in the XML document are left untouched, hence remain None.
+// Repeated elements are decoded into the already defined value, like encoding/xml does for plain fields,
+// so that slices are appended to rather than overwritten.
+func (m *Maybe[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if isTextType[T]() {
+		var text string
+		if err := d.DecodeElement(&text, &start); err != nil {
+			return fmt.Errorf("unmarshal maybe xml: %w", err)
+		}
+
+		return m.UnmarshalText([]byte(text))
+	}
+
+	var value T
+	if m.defined {
+		value = m.value
+	}
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return fmt.Errorf("unmarshal maybe xml: %w", err)
+	}
+
+	*m = Maybe[T]{defined: true, value: value}
+
+	return nil
+}
+
+// MarshalXMLAttr - omits the attribute for None and encodes Some(v) as the attribute holding v.
+func (m Maybe[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !m.defined {
+		return xml.Attr{}, nil
+	}
+
+	text, err := marshalText(m.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr - decodes the attribute as Some, empty attributes are decoded as None.
+func (m *Maybe[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return m.UnmarshalText([]byte(attr.Value))
+}
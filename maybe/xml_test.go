@@ -0,0 +1,116 @@
+package maybe_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+type xmlAddress struct {
+	City string `xml:"city"`
+}
+
+type xmlUser struct {
+	XMLName xml.Name                `xml:"user"`
+	ID      maybe.Maybe[int]        `xml:"id,attr"`
+	Name    maybe.Maybe[string]     `xml:"name"`
+	Age     *maybe.Maybe[int]       `xml:"age"`
+	Address maybe.Maybe[xmlAddress] `xml:"address"`
+	Note    maybe.Maybe[string]     `xml:"note"`
+}
+
+func Test_Maybe_MarshalXML(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`values present`, func(t *testing.T) {
+		t.Parallel()
+
+		user := xmlUser{
+			ID:      *maybe.Some(1),
+			Name:    *maybe.Some("john"),
+			Age:     maybe.Some(30),
+			Address: *maybe.Some(xmlAddress{City: "Warsaw"}),
+		}
+
+		res, err := xml.Marshal(user)
+
+		require.NoError(t, err)
+		assert.Equal(t,
+			`<user id="1"><name>john</name><age>30</age><address><city>Warsaw</city></address></user>`,
+			string(res),
+		)
+	})
+
+	t.Run(`no values present`, func(t *testing.T) {
+		t.Parallel()
+
+		user := xmlUser{Age: maybe.None[int]()}
+
+		res, err := xml.Marshal(user)
+
+		require.NoError(t, err)
+		assert.Equal(t, `<user></user>`, string(res))
+	})
+}
+
+func Test_Maybe_UnmarshalXML(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`values present`, func(t *testing.T) {
+		t.Parallel()
+
+		var user xmlUser
+		err := xml.Unmarshal(
+			[]byte(`<user id="1"><name>john</name><age>30</age><address><city>Warsaw</city></address></user>`),
+			&user,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, user.ID.OrZero())
+		assert.Equal(t, "john", user.Name.OrZero())
+		assert.Equal(t, 30, user.Age.OrZero())
+		assert.Equal(t, xmlAddress{City: "Warsaw"}, user.Address.OrZero())
+		assert.True(t, user.Note.IsEmpty())
+	})
+
+	t.Run(`empty and absent values`, func(t *testing.T) {
+		t.Parallel()
+
+		var user xmlUser
+		err := xml.Unmarshal([]byte(`<user id=""><name></name><age> </age></user>`), &user)
+
+		require.Error(t, err)
+
+		user = xmlUser{}
+		err = xml.Unmarshal([]byte(`<user id=""><name></name></user>`), &user)
+
+		require.NoError(t, err)
+		assert.True(t, user.ID.IsEmpty())
+		assert.True(t, user.Name.IsEmpty())
+		assert.True(t, user.Age.IsEmpty())
+		assert.True(t, user.Address.IsEmpty())
+	})
+
+	t.Run(`repeated elements round trip`, func(t *testing.T) {
+		t.Parallel()
+
+		type xmlTags struct {
+			XMLName xml.Name              `xml:"post"`
+			Tags    maybe.Maybe[[]string] `xml:"tag"`
+		}
+
+		data, err := xml.Marshal(xmlTags{Tags: *maybe.Some([]string{"a", "b"})})
+		require.NoError(t, err)
+		assert.Equal(t, `<post><tag>a</tag><tag>b</tag></post>`, string(data))
+
+		var res xmlTags
+		err = xml.Unmarshal(data, &res)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, res.Tags.OrZero())
+	})
+}
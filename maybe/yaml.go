@@ -0,0 +1,37 @@
+package maybe
+
+import "fmt"
+
+// MarshalYAML - implements gopkg.in/yaml.v3 Marshaler, encodes None as null and Some(v) as v.
+// Fields tagged with `omitempty` are dropped when None (yaml.v3 tests them using IsZero).
+func (m Maybe[T]) MarshalYAML() (any, error) {
+	if !m.defined {
+		return nil, nil //nolint:nilnil // nil is encoded as YAML null
+	}
+
+	return m.value, nil
+}
+
+// UnmarshalYAML - implements gopkg.in/yaml.v3 (and yaml.v2) obsolete Unmarshaler,
+// which doesn't require importing the yaml package.
+// Empty strings are decoded as None, null values and absent keys are left as None by the yaml decoder itself
+// (it doesn't call the unmarshaler for null values), hence null is never decoded as Null, unlike in JSON.
+func (m *Maybe[T]) UnmarshalYAML(unmarshal func(value any) error) error {
+	if isTextType[T]() {
+		var text string
+		if err := unmarshal(&text); err != nil {
+			return fmt.Errorf("unmarshal maybe yaml: %w", err)
+		}
+
+		return m.UnmarshalText([]byte(text))
+	}
+
+	var value T
+	if err := unmarshal(&value); err != nil {
+		return fmt.Errorf("unmarshal maybe yaml: %w", err)
+	}
+
+	*m = Maybe[T]{defined: true, value: value}
+
+	return nil
+}
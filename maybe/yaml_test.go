@@ -0,0 +1,94 @@
+package maybe_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+type yamlConfig struct {
+	Host    maybe.Maybe[string]        `yaml:"host"`
+	Port    *maybe.Maybe[int]          `yaml:"port"`
+	Timeout maybe.Maybe[time.Duration] `yaml:"timeout"`
+	Tags    maybe.Maybe[[]string]      `yaml:"tags"`
+	Debug   *maybe.Maybe[bool]         `yaml:"debug,omitempty"`
+	Name    maybe.Maybe[string]        `yaml:"name,omitempty"`
+}
+
+func Test_Maybe_MarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`values present`, func(t *testing.T) {
+		t.Parallel()
+
+		cfg := yamlConfig{
+			Host:    *maybe.Some("localhost"),
+			Port:    maybe.Some(8080),
+			Timeout: *maybe.Some(5 * time.Second),
+			Tags:    *maybe.Some([]string{"a"}),
+			Debug:   maybe.Some(false),
+			Name:    *maybe.Some("x"),
+		}
+
+		res, err := yaml.Marshal(cfg)
+
+		require.NoError(t, err)
+		assert.YAMLEq(t, "host: localhost\nport: 8080\ntimeout: 5s\ntags: [a]\ndebug: false\nname: x\n", string(res))
+	})
+
+	t.Run(`no values present`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := yaml.Marshal(yamlConfig{Debug: maybe.None[bool](), Name: *maybe.None[string]()})
+
+		require.NoError(t, err)
+		assert.YAMLEq(t, "host: null\nport: null\ntimeout: null\ntags: null\n", string(res))
+	})
+}
+
+func Test_Maybe_UnmarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`values present`, func(t *testing.T) {
+		t.Parallel()
+
+		var cfg yamlConfig
+		err := yaml.Unmarshal([]byte("host: localhost\nport: 8080\ntimeout: 5s\ntags: [a]\ndebug: true\n"), &cfg)
+
+		require.NoError(t, err)
+		assert.Equal(t, "localhost", cfg.Host.OrZero())
+		assert.Equal(t, 8080, cfg.Port.OrZero())
+		assert.Equal(t, 5*time.Second, cfg.Timeout.OrZero())
+		assert.Equal(t, []string{"a"}, cfg.Tags.OrZero())
+		assert.True(t, cfg.Debug.OrZero())
+	})
+
+	t.Run(`empty, null and absent values`, func(t *testing.T) {
+		t.Parallel()
+
+		var cfg yamlConfig
+		err := yaml.Unmarshal([]byte("host: \"\"\nport: null\ntimeout: ~\n"), &cfg)
+
+		require.NoError(t, err)
+		assert.True(t, cfg.Host.IsEmpty())
+		assert.True(t, cfg.Port.IsEmpty())
+		assert.True(t, cfg.Timeout.IsEmpty())
+		assert.True(t, cfg.Tags.IsEmpty())
+		assert.True(t, cfg.Debug.IsEmpty())
+	})
+
+	t.Run(`invalid value`, func(t *testing.T) {
+		t.Parallel()
+
+		var cfg yamlConfig
+		err := yaml.Unmarshal([]byte("port: http\n"), &cfg)
+
+		require.Error(t, err)
+		assert.True(t, cfg.Port.IsEmpty())
+	})
+}
@@ -0,0 +1,204 @@
+package result
+
+import (
+	"errors"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
+var (
+	ErrNilResult   = errors.New("nil result")
+	ErrNilError    = errors.New("nil result error")
+	ErrFilteredOut = errors.New("result filtered out")
+)
+
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+//nolint:unused // sealed interface
+func (r *Result[T]) sealedResult() {}
+
+func (r *Result[T]) IsOk() bool {
+	return !r.IsErr()
+}
+
+func (r *Result[T]) IsErr() bool {
+	return r == nil || r.err != nil
+}
+
+func (r *Result[T]) Get() (T, error) {
+	if r.IsErr() {
+		var zero T
+		return zero, r.Err()
+	}
+
+	return r.value, nil
+}
+
+// Err - returns the error held by the Result or nil for an Ok result.
+func (r *Result[T]) Err() error {
+	if r == nil {
+		return ErrNilResult
+	}
+
+	return r.err
+}
+
+func (r *Result[T]) OrZero() T {
+	if r.IsErr() {
+		var zero T
+		return zero
+	}
+
+	return r.value
+}
+
+func (r *Result[T]) OrElse(other T) T {
+	if r.IsErr() {
+		return other
+	}
+
+	return r.value
+}
+
+func (r *Result[T]) OrElseGet(other func() T) T {
+	if r.IsErr() {
+		return other()
+	}
+
+	return r.value
+}
+
+// OrElseGetErr - like OrElseGet, but the fallback receives the error.
+func (r *Result[T]) OrElseGetErr(other func(err error) T) T {
+	if r.IsErr() {
+		return other(r.Err())
+	}
+
+	return r.value
+}
+
+func (r *Result[T]) OrElseTryGet(other func() (T, error)) (T, error) {
+	if r.IsErr() {
+		return other()
+	}
+
+	return r.value, nil
+}
+
+// OrElseTryGetErr - like OrElseTryGet, but the fallback receives the error.
+func (r *Result[T]) OrElseTryGetErr(other func(err error) (T, error)) (T, error) {
+	if r.IsErr() {
+		return other(r.Err())
+	}
+
+	return r.value, nil
+}
+
+// Filter - returns Err(ErrFilteredOut) if the Ok value doesn't match the predicate.
+func (r *Result[T]) Filter(pred func(T) bool) *Result[T] {
+	if r.IsErr() {
+		return r
+	}
+	if !pred(r.value) {
+		return Err[T](ErrFilteredOut)
+	}
+	return r
+}
+
+func (r *Result[T]) Map(fn func(T) T) *Result[T] {
+	if r.IsErr() {
+		return r
+	}
+	return Ok(fn(r.value))
+}
+
+func (r *Result[T]) FlatMap(fn func(T) *Result[T]) *Result[T] {
+	if r.IsErr() {
+		return r
+	}
+	return fn(r.value)
+}
+
+// ToMaybe - converts Ok(v) into Some(v) and Err into None, dropping the error.
+func (r *Result[T]) ToMaybe() *maybe.Maybe[T] {
+	if r.IsErr() {
+		return maybe.None[T]()
+	}
+
+	return maybe.Some(r.value)
+}
+
+func Ok[T any](value T) *Result[T] {
+	return &Result[T]{value: value}
+}
+
+// Err - creates a failed Result, nil err is replaced with ErrNilError, so that the Result never becomes Ok.
+func Err[T any](err error) *Result[T] {
+	if err == nil {
+		err = ErrNilError
+	}
+
+	return &Result[T]{err: err}
+}
+
+// Of - creates a Result from the (value, error) pair.
+func Of[T any](value T, err error) *Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+
+	return Ok(value)
+}
+
+// FromMaybe - converts Some(v) into Ok(v) and None into Err(err), nil err is replaced with maybe.ErrEmptyMaybe.
+func FromMaybe[T any](mb *maybe.Maybe[T], err error) *Result[T] {
+	if mb.IsEmpty() {
+		if err == nil {
+			err = maybe.ErrEmptyMaybe
+		}
+
+		return Err[T](err)
+	}
+
+	return Ok(mb.OrZero())
+}
+
+func Map[T, U any](r *Result[T], fn func(T) U) *Result[U] {
+	if r.IsErr() {
+		return Err[U](r.Err())
+	}
+
+	return Ok(fn(r.value))
+}
+
+func TryMap[T, U any](r *Result[T], fn func(T) (U, error)) *Result[U] {
+	if r.IsErr() {
+		return Err[U](r.Err())
+	}
+
+	return Of(fn(r.value))
+}
+
+func FlatMap[T, U any](r *Result[T], fn func(T) *Result[U]) *Result[U] {
+	if r.IsErr() {
+		return Err[U](r.Err())
+	}
+
+	return fn(r.value)
+}
+
+func TryFlatMap[T, U any](r *Result[T], fn func(T) (*Result[U], error)) *Result[U] {
+	if r.IsErr() {
+		return Err[U](r.Err())
+	}
+
+	res, err := fn(r.value)
+	if err != nil {
+		return Err[U](err)
+	}
+
+	return res
+}
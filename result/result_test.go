@@ -0,0 +1,380 @@
+package result_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/maybe"
+	"github.com/tompaz3/fungo/result"
+)
+
+var errTest = errors.New("test error")
+
+func Test_Result_IsOk(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Ok(1)
+
+		assert.True(t, res.IsOk())
+		assert.False(t, res.IsErr())
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Err[int](errTest)
+
+		assert.False(t, res.IsOk())
+		assert.True(t, res.IsErr())
+	})
+
+	t.Run(`nil err`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Err[int](nil)
+
+		assert.False(t, res.IsOk())
+		assert.True(t, res.IsErr())
+		assert.Equal(t, result.ErrNilError, res.Err())
+		assert.True(t, res.ToMaybe().IsEmpty())
+	})
+
+	t.Run(`nil pointer`, func(t *testing.T) {
+		t.Parallel()
+
+		var res *result.Result[int]
+
+		assert.False(t, res.IsOk())
+		assert.True(t, res.IsErr())
+		assert.Equal(t, result.ErrNilResult, res.Err())
+	})
+}
+
+func Test_Result_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := result.Ok(1).Get()
+
+		assert.Equal(t, 1, res)
+		assert.NoError(t, err)
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := result.Err[int](errTest).Get()
+
+		assert.Zero(t, res)
+		assert.Equal(t, errTest, err)
+	})
+}
+
+func Test_Result_Of(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`no error`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Of(strconv.Atoi("1"))
+
+		assert.True(t, res.IsOk())
+		assert.Equal(t, 1, res.OrZero())
+	})
+
+	t.Run(`error`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Of(strconv.Atoi("one"))
+
+		assert.True(t, res.IsErr())
+		assert.Error(t, res.Err())
+	})
+}
+
+func Test_Result_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 1, result.Ok(1).OrElse(2))
+		assert.Equal(t, 1, result.Ok(1).OrElseGet(func() int { return 2 }))
+		assert.Equal(t, 1, result.Ok(1).OrElseGetErr(func(_ error) int { return 2 }))
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		var receivedErr error
+
+		assert.Equal(t, 2, result.Err[int](errTest).OrElse(2))
+		assert.Equal(t, 2, result.Err[int](errTest).OrElseGet(func() int { return 2 }))
+		assert.Equal(t, 2, result.Err[int](errTest).OrElseGetErr(func(err error) int {
+			receivedErr = err
+			return 2
+		}))
+		assert.Equal(t, errTest, receivedErr)
+	})
+}
+
+func Test_Result_OrElseTryGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := result.Ok(1).OrElseTryGet(func() (int, error) { return 0, errTest })
+
+		assert.Equal(t, 1, res)
+		assert.NoError(t, err)
+
+		res, err = result.Ok(1).OrElseTryGetErr(func(_ error) (int, error) { return 0, errTest })
+
+		assert.Equal(t, 1, res)
+		assert.NoError(t, err)
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		res, err := result.Err[int](errTest).OrElseTryGet(func() (int, error) { return 2, nil })
+
+		assert.Equal(t, 2, res)
+		assert.NoError(t, err)
+
+		res, err = result.Err[int](errTest).OrElseTryGetErr(func(err error) (int, error) { return 2, err })
+
+		assert.Equal(t, 2, res)
+		assert.Equal(t, errTest, err)
+	})
+}
+
+func Test_Result_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`predicate returns true`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Ok(1).Filter(func(i int) bool { return i == 1 })
+
+		assert.True(t, res.IsOk())
+	})
+
+	t.Run(`predicate returns false`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Ok(1).Filter(func(i int) bool { return i == 2 })
+
+		assert.True(t, res.IsErr())
+		assert.Equal(t, result.ErrFilteredOut, res.Err())
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		predicateEvaluated := false
+		res := result.Err[int](errTest).Filter(func(_ int) bool {
+			predicateEvaluated = true
+			return true
+		})
+
+		assert.Equal(t, errTest, res.Err())
+		assert.False(t, predicateEvaluated)
+	})
+}
+
+func Test_Result_Map_Receiver(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Ok(1).Map(func(i int) int { return i * 2 })
+
+		assert.Equal(t, 2, res.OrZero())
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		mapperEvaluated := false
+		res := result.Err[int](errTest).Map(func(i int) int {
+			mapperEvaluated = true
+			return i * 2
+		})
+
+		assert.Equal(t, errTest, res.Err())
+		assert.False(t, mapperEvaluated)
+	})
+}
+
+func Test_Result_FlatMap_Receiver(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`mapper returned ok`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Ok(1).FlatMap(func(i int) *result.Result[int] { return result.Ok(i * 2) })
+
+		assert.Equal(t, 2, res.OrZero())
+	})
+
+	t.Run(`mapper returned err`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Ok(1).FlatMap(func(_ int) *result.Result[int] { return result.Err[int](errTest) })
+
+		assert.Equal(t, errTest, res.Err())
+	})
+}
+
+func Test_Map(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Map(result.Ok(1), strconv.Itoa)
+
+		assert.Equal(t, "1", res.OrZero())
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Map(result.Err[int](errTest), strconv.Itoa)
+
+		assert.Equal(t, errTest, res.Err())
+	})
+}
+
+func Test_TryMap(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`mapping returns value`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.TryMap(result.Ok("1"), strconv.Atoi)
+
+		assert.Equal(t, 1, res.OrZero())
+	})
+
+	t.Run(`mapping returns error`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.TryMap(result.Ok("one"), strconv.Atoi)
+
+		assert.True(t, res.IsErr())
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.TryMap(result.Err[string](errTest), strconv.Atoi)
+
+		assert.Equal(t, errTest, res.Err())
+	})
+}
+
+func Test_FlatMap(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.FlatMap(result.Ok("1"), func(s string) *result.Result[int] {
+			return result.Of(strconv.Atoi(s))
+		})
+
+		assert.Equal(t, 1, res.OrZero())
+	})
+
+	t.Run(`err`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.FlatMap(result.Err[string](errTest), func(s string) *result.Result[int] {
+			return result.Of(strconv.Atoi(s))
+		})
+
+		assert.Equal(t, errTest, res.Err())
+	})
+}
+
+func Test_TryFlatMap(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`mapper returned ok`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.TryFlatMap(result.Ok(1), func(i int) (*result.Result[string], error) {
+			return result.Ok(strconv.Itoa(i)), nil
+		})
+
+		assert.Equal(t, "1", res.OrZero())
+	})
+
+	t.Run(`mapper returned error`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.TryFlatMap(result.Ok(1), func(_ int) (*result.Result[string], error) {
+			return nil, errTest
+		})
+
+		assert.Equal(t, errTest, res.Err())
+	})
+}
+
+func Test_Maybe_Conversions(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`ok to maybe`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Ok(1).ToMaybe()
+
+		assert.Equal(t, 1, res.OrZero())
+	})
+
+	t.Run(`err to maybe`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.Err[int](errTest).ToMaybe()
+
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`some to result`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.FromMaybe(maybe.Some(1), errTest)
+
+		assert.Equal(t, 1, res.OrZero())
+	})
+
+	t.Run(`none to result`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.FromMaybe(maybe.None[int](), errTest)
+
+		assert.Equal(t, errTest, res.Err())
+	})
+
+	t.Run(`none to result with nil err`, func(t *testing.T) {
+		t.Parallel()
+
+		res := result.FromMaybe(maybe.None[int](), nil)
+
+		assert.True(t, res.IsErr())
+		assert.Equal(t, maybe.ErrEmptyMaybe, res.Err())
+		assert.True(t, res.ToMaybe().IsEmpty())
+	})
+}
@@ -0,0 +1,169 @@
+package ternary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/ternary"
+)
+
+const elseIfString = "else if"
+
+func Test_Ternary_ElseIf(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`first condition true`, func(t *testing.T) {
+		t.Parallel()
+
+		elseIfConditionEvaluated := false
+		elseIfEvaluated := false
+
+		res := ternary.If[string](true).
+			Then(thenString).
+			ElseIfF(func() bool {
+				elseIfConditionEvaluated = true
+				return true
+			}).
+			ThenF(func() string {
+				elseIfEvaluated = true
+				return elseIfString
+			}).
+			Else(elseString)
+
+		assert.False(t, elseIfConditionEvaluated)
+		assert.False(t, elseIfEvaluated)
+		assert.Equal(t, thenString, res)
+	})
+
+	t.Run(`second condition true`, func(t *testing.T) {
+		t.Parallel()
+
+		thenEvaluated := false
+		elseEvaluated := false
+
+		res := ternary.If[string](false).
+			ThenF(func() string {
+				thenEvaluated = true
+				return thenString
+			}).
+			ElseIf(true).
+			Then(elseIfString).
+			ElseF(func() string {
+				elseEvaluated = true
+				return elseString
+			})
+
+		assert.False(t, thenEvaluated)
+		assert.False(t, elseEvaluated)
+		assert.Equal(t, elseIfString, res)
+	})
+
+	t.Run(`conditions evaluated in order`, func(t *testing.T) {
+		t.Parallel()
+
+		evaluated := make([]int, 0)
+		condition := func(i int, result bool) func() bool {
+			return func() bool {
+				evaluated = append(evaluated, i)
+				return result
+			}
+		}
+
+		res := ternary.IfF[int](condition(1, false)).
+			Then(1).
+			ElseIfF(condition(2, false)).
+			Then(2).
+			ElseIfF(condition(3, true)).
+			Then(3).
+			ElseIfF(condition(4, true)).
+			Then(4).
+			Else(5)
+
+		assert.Equal(t, []int{1, 2, 3}, evaluated)
+		assert.Equal(t, 3, res)
+	})
+
+	t.Run(`all conditions false`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.If[string](false).
+			Then(thenString).
+			ElseIf(false).
+			Then(elseIfString).
+			Else(elseString)
+
+		assert.Equal(t, elseString, res)
+	})
+}
+
+func Test_Cond(t *testing.T) {
+	t.Parallel()
+
+	price := func(amount int) string {
+		return ternary.Cond(
+			ternary.IfThen(amount > 100, "premium"),
+			ternary.IfThen(amount > 50, "standard"),
+		).Else("budget")
+	}
+
+	t.Run(`first branch matched`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "premium", price(150))
+	})
+
+	t.Run(`second branch matched`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "standard", price(75))
+	})
+
+	t.Run(`no branch matched`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "budget", price(10))
+	})
+
+	t.Run(`lazily evaluated branches`, func(t *testing.T) {
+		t.Parallel()
+
+		secondEvaluated := false
+
+		res := ternary.Cond(
+			ternary.IfThenF(func() bool { return true }, func() string { return thenString }),
+			ternary.IfThenF(
+				func() bool {
+					secondEvaluated = true
+					return true
+				},
+				func() string { return elseIfString },
+			),
+		).ElseF(func() string { return elseString })
+
+		assert.False(t, secondEvaluated)
+		assert.Equal(t, thenString, res)
+	})
+
+	t.Run(`no branches`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.Cond[string]().Else(elseString)
+
+		assert.Equal(t, elseString, res)
+	})
+
+	t.Run(`zero value branches skipped`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.Cond(
+			ternary.Branch[string]{},
+			ternary.IfThenF[string](nil, func() string { return thenString }),
+			ternary.IfThenF[string](func() bool { return true }, nil),
+			ternary.IfThen(true, elseIfString),
+		).Else(elseString)
+
+		assert.Equal(t, elseIfString, res)
+		assert.Equal(t, elseString, ternary.Cond(ternary.Branch[string]{}).Else(elseString))
+	})
+}
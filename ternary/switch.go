@@ -0,0 +1,82 @@
+package ternary
+
+type SwitchCase[K comparable, T any] interface {
+	Case(key K, then T) SwitchCase[K, T]
+	CaseF(key K, f func() T) SwitchCase[K, T]
+	Default(other T) T
+	DefaultF(f func() T) T
+}
+
+type SwitchPredicateCase[K any, T any] interface {
+	Case(pred func(K) bool, then T) SwitchPredicateCase[K, T]
+	CaseF(pred func(K) bool, f func() T) SwitchPredicateCase[K, T]
+	Default(other T) T
+	DefaultF(f func() T) T
+}
+
+type switchExpr[K comparable, T any] struct {
+	predicateSwitch switchPredicateExpr[K, T]
+}
+
+type switchPredicateExpr[K any, T any] struct {
+	key   K
+	chain ternary[T]
+}
+
+var (
+	_ SwitchCase[int, any]          = (*switchExpr[int, any])(nil)
+	_ SwitchPredicateCase[any, any] = (*switchPredicateExpr[any, any])(nil)
+)
+
+func (s *switchExpr[K, T]) Case(key K, then T) SwitchCase[K, T] {
+	s.predicateSwitch.Case(equal(key), then)
+	return s
+}
+
+func (s *switchExpr[K, T]) CaseF(key K, fn func() T) SwitchCase[K, T] {
+	s.predicateSwitch.CaseF(equal(key), fn)
+	return s
+}
+
+func (s *switchExpr[K, T]) Default(other T) T {
+	return s.predicateSwitch.Default(other)
+}
+
+func (s *switchExpr[K, T]) DefaultF(fn func() T) T {
+	return s.predicateSwitch.DefaultF(fn)
+}
+
+func (s *switchPredicateExpr[K, T]) Case(pred func(K) bool, then T) SwitchPredicateCase[K, T] {
+	return s.CaseF(pred, func() T { return then })
+}
+
+func (s *switchPredicateExpr[K, T]) CaseF(pred func(K) bool, fn func() T) SwitchPredicateCase[K, T] {
+	s.chain.branches = append(s.chain.branches, IfThenF(func() bool { return pred(s.key) }, fn))
+	return s
+}
+
+func (s *switchPredicateExpr[K, T]) Default(other T) T {
+	return s.chain.Else(other)
+}
+
+func (s *switchPredicateExpr[K, T]) DefaultF(fn func() T) T {
+	return s.chain.ElseF(fn)
+}
+
+// Switch - initiates switch expression, which returns the value of the first case equal to the key.
+func Switch[K comparable, T any](key K) SwitchCase[K, T] {
+	return &switchExpr[K, T]{
+		predicateSwitch: switchPredicateExpr[K, T]{key: key},
+	}
+}
+
+// SwitchPredicate - initiates switch expression, which returns the value of the first case matching the key.
+func SwitchPredicate[K any, T any](key K) SwitchPredicateCase[K, T] {
+	return &switchPredicateExpr[K, T]{key: key}
+}
+
+func equal[K comparable](key K) func(K) bool {
+	return func(other K) bool {
+		return key == other
+	}
+}
@@ -0,0 +1,139 @@
+package ternary_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/ternary"
+)
+
+type orderStatus int
+
+const (
+	orderStatusCreated orderStatus = iota
+	orderStatusPaid
+	orderStatusCancelled
+	orderStatusUnknown
+)
+
+func Test_Switch(t *testing.T) {
+	t.Parallel()
+
+	statusCode := func(status orderStatus) int {
+		return ternary.Switch[orderStatus, int](status).
+			Case(orderStatusCreated, http.StatusCreated).
+			Case(orderStatusPaid, http.StatusOK).
+			Case(orderStatusCancelled, http.StatusGone).
+			Default(http.StatusInternalServerError)
+	}
+
+	t.Run(`first case matched`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, http.StatusCreated, statusCode(orderStatusCreated))
+	})
+
+	t.Run(`last case matched`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, http.StatusGone, statusCode(orderStatusCancelled))
+	})
+
+	t.Run(`no case matched`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, http.StatusInternalServerError, statusCode(orderStatusUnknown))
+	})
+
+	t.Run(`lazily evaluated cases`, func(t *testing.T) {
+		t.Parallel()
+
+		evaluated := make([]string, 0)
+
+		res := ternary.Switch[orderStatus, string](orderStatusPaid).
+			CaseF(orderStatusCreated, func() string {
+				evaluated = append(evaluated, "created")
+				return "created"
+			}).
+			CaseF(orderStatusPaid, func() string {
+				evaluated = append(evaluated, "paid")
+				return "paid"
+			}).
+			CaseF(orderStatusPaid, func() string {
+				evaluated = append(evaluated, "paid again")
+				return "paid again"
+			}).
+			DefaultF(func() string {
+				evaluated = append(evaluated, "default")
+				return "default"
+			})
+
+		assert.Equal(t, "paid", res)
+		assert.Equal(t, []string{"paid"}, evaluated)
+	})
+
+	t.Run(`lazily evaluated default`, func(t *testing.T) {
+		t.Parallel()
+
+		defaultEvaluated := false
+
+		res := ternary.Switch[orderStatus, string](orderStatusUnknown).
+			Case(orderStatusCreated, "created").
+			DefaultF(func() string {
+				defaultEvaluated = true
+				return "unknown"
+			})
+
+		assert.True(t, defaultEvaluated)
+		assert.Equal(t, "unknown", res)
+	})
+}
+
+func Test_SwitchPredicate(t *testing.T) {
+	t.Parallel()
+
+	label := func(amount float64) string {
+		return ternary.SwitchPredicate[float64, string](amount).
+			Case(func(a float64) bool { return a < 0 }, "refund").
+			Case(func(a float64) bool { return a == 0 }, "free").
+			CaseF(func(a float64) bool { return a > 1000 }, func() string { return "large" }).
+			Default("regular")
+	}
+
+	t.Run(`case matched`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "refund", label(-1))
+		assert.Equal(t, "free", label(0))
+		assert.Equal(t, "large", label(1001))
+	})
+
+	t.Run(`no case matched`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "regular", label(10))
+	})
+
+	t.Run(`predicates evaluated in order until matched`, func(t *testing.T) {
+		t.Parallel()
+
+		evaluated := make([]int, 0)
+		pred := func(i int, result bool) func([]int) bool {
+			return func(_ []int) bool {
+				evaluated = append(evaluated, i)
+				return result
+			}
+		}
+
+		res := ternary.SwitchPredicate[[]int, int]([]int{1}).
+			Case(pred(1, false), 1).
+			Case(pred(2, true), 2).
+			Case(pred(3, true), 3).
+			Default(4)
+
+		assert.Equal(t, 2, res)
+		assert.Equal(t, []int{1, 2}, evaluated)
+	})
+}
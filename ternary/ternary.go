@@ -1,57 +1,133 @@
 package ternary
 
+import (
+	"slices"
+
+	"github.com/tompaz3/fungo/maybe"
+)
+
 type Ternary[T any] interface {
 	Then(then T) Then[T]
 	ThenF(f func() T) Then[T]
+	ThenMaybe(then T) *maybe.Maybe[T]
+	ThenMaybeF(f func() T) *maybe.Maybe[T]
 }
 
 type Then[T any] interface {
 	Else(other T) T
 	ElseF(f func() T) T
+	ElseIf(condition bool) Ternary[T]
+	ElseIfF(condition func() bool) Ternary[T]
 }
 
-type ternary[T any] struct {
+// Branch - single condition / value pair of the conditional chain, built with IfThen or IfThenF.
+// Zero value Branch (as well as Branch with nil condition or value function) never matches.
+type Branch[T any] struct {
 	condition func() bool
 	then      func() T
 }
 
+type ternary[T any] struct {
+	branches []Branch[T]
+}
+
 var (
 	_ Ternary[any] = (*ternary[any])(nil)
 	_ Then[any]    = (*ternary[any])(nil)
 )
 
 func (t *ternary[T]) Then(then T) Then[T] {
-	t.then = func() T { return then }
+	t.branches[len(t.branches)-1].then = func() T { return then }
 	return t
 }
 
 func (t *ternary[T]) ThenF(fn func() T) Then[T] {
-	t.then = fn
+	t.branches[len(t.branches)-1].then = fn
+	return t
+}
+
+// ThenMaybe - completes the chain without else branch, returns Some(then) if matched (or previous branch value)
+// and None if none of the conditions matched.
+func (t *ternary[T]) ThenMaybe(then T) *maybe.Maybe[T] {
+	return t.ThenMaybeF(func() T { return then })
+}
+
+// ThenMaybeF - completes the chain without else branch, the same way ThenMaybe does, but evaluates the value lazily.
+func (t *ternary[T]) ThenMaybeF(fn func() T) *maybe.Maybe[T] {
+	t.ThenF(fn)
+	if then, ok := t.match(); ok {
+		return maybe.Some(then())
+	}
+	return maybe.None[T]()
+}
+
+func (t *ternary[T]) ElseIf(condition bool) Ternary[T] {
+	t.branches = append(t.branches, Branch[T]{condition: func() bool { return condition }})
+	return t
+}
+
+func (t *ternary[T]) ElseIfF(condition func() bool) Ternary[T] {
+	t.branches = append(t.branches, Branch[T]{condition: condition})
 	return t
 }
 
 func (t *ternary[T]) Else(other T) T {
-	if t.condition() {
-		return t.then()
+	if then, ok := t.match(); ok {
+		return then()
 	}
 	return other
 }
 
 func (t *ternary[T]) ElseF(fn func() T) T {
-	if t.condition() {
-		return t.then()
+	if then, ok := t.match(); ok {
+		return then()
 	}
 	return fn()
 }
 
+// match - evaluates the conditions in order and returns the value function of the first branch matched.
+// Branches with nil condition or value function are skipped.
+func (t *ternary[T]) match() (func() T, bool) {
+	for _, branch := range t.branches {
+		if branch.condition == nil || branch.then == nil {
+			continue
+		}
+		if branch.condition() {
+			return branch.then, true
+		}
+	}
+	return nil, false
+}
+
 func If[T any](condition bool) Ternary[T] {
 	return &ternary[T]{
-		condition: func() bool { return condition },
+		branches: []Branch[T]{{condition: func() bool { return condition }}},
 	}
 }
 
 func IfF[T any](condition func() bool) Ternary[T] {
 	return &ternary[T]{
+		branches: []Branch[T]{{condition: condition}},
+	}
+}
+
+// Cond - creates a conditional chain from the branches, which are evaluated in order.
+func Cond[T any](branches ...Branch[T]) Then[T] {
+	return &ternary[T]{branches: slices.Clone(branches)}
+}
+
+// IfThen - creates a branch for Cond with eagerly evaluated condition and value.
+func IfThen[T any](condition bool, then T) Branch[T] {
+	return Branch[T]{
+		condition: func() bool { return condition },
+		then:      func() T { return then },
+	}
+}
+
+// IfThenF - creates a branch for Cond with lazily evaluated condition and value.
+func IfThenF[T any](condition func() bool, then func() T) Branch[T] {
+	return Branch[T]{
 		condition: condition,
+		then:      then,
 	}
 }
@@ -0,0 +1,34 @@
+package ternary
+
+import "github.com/tompaz3/fungo/maybe"
+
+type MaybeThen[T any] interface {
+	Then(then T) *maybe.Maybe[T]
+	ThenF(f func() T) *maybe.Maybe[T]
+}
+
+type when[T any] struct {
+	chain Ternary[T]
+}
+
+var _ MaybeThen[any] = (*when[any])(nil)
+
+func (w *when[T]) Then(then T) *maybe.Maybe[T] {
+	return w.chain.ThenMaybe(then)
+}
+
+func (w *when[T]) ThenF(fn func() T) *maybe.Maybe[T] {
+	return w.chain.ThenMaybeF(fn)
+}
+
+// When - initiates conditional without else branch with eagerly evaluated condition,
+// which results in Some(value) if the condition is true and None otherwise.
+func When[T any](condition bool) MaybeThen[T] {
+	return &when[T]{chain: If[T](condition)}
+}
+
+// WhenF - initiates conditional without else branch with lazily evaluated condition,
+// which results in Some(value) if the condition is true and None otherwise.
+func WhenF[T any](condition func() bool) MaybeThen[T] {
+	return &when[T]{chain: IfF[T](condition)}
+}
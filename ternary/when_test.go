@@ -0,0 +1,103 @@
+package ternary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/ternary"
+)
+
+func Test_When(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`condition true`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.When[int](true).Then(0)
+
+		assert.True(t, res.IsDefined())
+		assert.Equal(t, 0, res.OrElse(1))
+	})
+
+	t.Run(`condition false`, func(t *testing.T) {
+		t.Parallel()
+
+		thenEvaluated := false
+
+		res := ternary.When[int](false).ThenF(func() int {
+			thenEvaluated = true
+			return 0
+		})
+
+		assert.True(t, res.IsEmpty())
+		assert.False(t, thenEvaluated)
+	})
+
+	t.Run(`lazily evaluated condition`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.WhenF[string](func() bool { return true }).Then(thenString)
+
+		assert.Equal(t, thenString, res.OrZero())
+	})
+}
+
+func Test_Ternary_ThenMaybe(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`condition true`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.If[string](true).ThenMaybe(thenString)
+
+		assert.Equal(t, thenString, res.OrZero())
+	})
+
+	t.Run(`condition false`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.If[string](false).ThenMaybe(thenString)
+
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run(`previous branch matched`, func(t *testing.T) {
+		t.Parallel()
+
+		elseIfEvaluated := false
+
+		res := ternary.If[string](true).
+			Then(thenString).
+			ElseIf(true).
+			ThenMaybeF(func() string {
+				elseIfEvaluated = true
+				return elseIfString
+			})
+
+		assert.Equal(t, thenString, res.OrZero())
+		assert.False(t, elseIfEvaluated)
+	})
+
+	t.Run(`last branch matched`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.If[string](false).
+			Then(thenString).
+			ElseIf(true).
+			ThenMaybe(elseIfString)
+
+		assert.Equal(t, elseIfString, res.OrZero())
+	})
+
+	t.Run(`no branch matched`, func(t *testing.T) {
+		t.Parallel()
+
+		res := ternary.If[string](false).
+			Then(thenString).
+			ElseIf(false).
+			ThenMaybe(elseIfString)
+
+		assert.True(t, res.IsEmpty())
+	})
+}
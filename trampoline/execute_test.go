@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2024-2025 Tomasz Paździurek
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package trampoline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tompaz3/fungo/trampoline"
+)
+
+func countdown(n int) trampoline.Trampoline[int] {
+	if n == 0 {
+		return trampoline.Complete(0)
+	}
+
+	return trampoline.Next(func() trampoline.Trampoline[int] {
+		return countdown(n - 1)
+	})
+}
+
+func infinite() trampoline.Trampoline[int] {
+	return trampoline.Next(infinite)
+}
+
+func Test_ExecuteWithLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`completes within the limit`, func(t *testing.T) {
+		t.Parallel()
+
+		res, steps, err := countdown(10).ExecuteWithLimit(10)
+
+		require.NoError(t, err)
+		assert.Zero(t, res)
+		assert.Equal(t, 10, steps)
+	})
+
+	t.Run(`complete trampoline`, func(t *testing.T) {
+		t.Parallel()
+
+		res, steps, err := trampoline.Complete(1).ExecuteWithLimit(0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, res)
+		assert.Zero(t, steps)
+	})
+
+	t.Run(`exceeds the limit`, func(t *testing.T) {
+		t.Parallel()
+
+		res, steps, err := infinite().ExecuteWithLimit(1_000)
+
+		require.ErrorIs(t, err, trampoline.ErrStepLimitExceeded)
+		assert.Zero(t, res)
+		assert.Equal(t, 1_000, steps)
+	})
+
+	t.Run(`counts flat map steps`, func(t *testing.T) {
+		t.Parallel()
+
+		res, steps, err := sum(10).ExecuteWithLimit(100)
+
+		require.NoError(t, err)
+		assert.Equal(t, 55, res)
+		assert.Equal(t, 30, steps)
+
+		_, steps, err = sum(10).ExecuteWithLimit(29)
+
+		require.ErrorIs(t, err, trampoline.ErrStepLimitExceeded)
+		assert.Equal(t, 29, steps)
+	})
+
+	t.Run(`counts steps of next calls followed by flat map`, func(t *testing.T) {
+		t.Parallel()
+
+		tr := trampoline.Next(func() trampoline.Trampoline[int] {
+			return trampoline.Next(func() trampoline.Trampoline[int] {
+				return sum(10)
+			})
+		})
+
+		res, steps, err := tr.ExecuteWithLimit(100)
+
+		require.NoError(t, err)
+		assert.Equal(t, 55, res)
+		assert.Equal(t, 32, steps)
+
+		_, steps, err = tr.ExecuteWithLimit(31)
+
+		require.ErrorIs(t, err, trampoline.ErrStepLimitExceeded)
+		assert.Equal(t, 31, steps)
+	})
+}
+
+func Test_ExecuteContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`completes before context is done`, func(t *testing.T) {
+		t.Parallel()
+
+		res, steps, err := countdown(10).ExecuteContext(context.Background())
+
+		require.NoError(t, err)
+		assert.Zero(t, res)
+		assert.Equal(t, 10, steps)
+	})
+
+	t.Run(`context already cancelled`, func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res, steps, err := countdown(10).ExecuteContext(ctx)
+
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, res)
+		assert.Zero(t, steps)
+	})
+
+	t.Run(`context deadline exceeded`, func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, steps, err := infinite().ExecuteContext(ctx)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Positive(t, steps)
+	})
+}
+
+func Benchmark_Execute_Next(b *testing.B) {
+	for range b.N {
+		_ = countdown(1_000).Execute()
+	}
+}
+
+func Benchmark_Execute_FlatMap(b *testing.B) {
+	for range b.N {
+		_ = sum(1_000).Execute()
+	}
+}
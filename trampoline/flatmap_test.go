@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2024-2025 Tomasz Paździurek
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package trampoline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tompaz3/fungo/trampoline"
+)
+
+func fibonacci(n int) trampoline.Trampoline[int] {
+	if n < 2 {
+		return trampoline.Complete(n)
+	}
+
+	return trampoline.FlatMap(
+		trampoline.Next(func() trampoline.Trampoline[int] { return fibonacci(n - 1) }),
+		func(a int) trampoline.Trampoline[int] {
+			return trampoline.Map(
+				trampoline.Next(func() trampoline.Trampoline[int] { return fibonacci(n - 2) }),
+				func(b int) int { return a + b },
+			)
+		},
+	)
+}
+
+func sum(n int) trampoline.Trampoline[int] {
+	if n == 0 {
+		return trampoline.Complete(0)
+	}
+
+	return trampoline.Map(
+		trampoline.Next(func() trampoline.Trampoline[int] { return sum(n - 1) }),
+		func(res int) int { return n + res },
+	)
+}
+
+func isEven(n int) trampoline.Trampoline[bool] {
+	if n == 0 {
+		return trampoline.Complete(true)
+	}
+
+	return trampoline.Map(
+		trampoline.Next(func() trampoline.Trampoline[string] { return parity(n - 1) }),
+		func(label string) bool { return label == "odd" },
+	)
+}
+
+func parity(n int) trampoline.Trampoline[string] {
+	return trampoline.Map(
+		trampoline.Next(func() trampoline.Trampoline[bool] { return isEven(n) }),
+		func(even bool) string { return parityLabel(even) },
+	)
+}
+
+func parityLabel(even bool) string {
+	if even {
+		return "even"
+	}
+
+	return "odd"
+}
+
+func Test_FlatMap(t *testing.T) {
+	t.Parallel()
+
+	t.Run(`non-tail recursion`, func(t *testing.T) {
+		t.Parallel()
+
+		res := fibonacci(20).Execute()
+
+		assert.Equal(t, 6765, res)
+	})
+
+	t.Run(`deep non-tail recursion`, func(t *testing.T) {
+		t.Parallel()
+
+		n := 1_000_000
+
+		res := sum(n).Execute()
+
+		assert.Equal(t, n*(n+1)/2, res)
+	})
+
+	t.Run(`deep left-nested chain`, func(t *testing.T) {
+		t.Parallel()
+
+		tr := trampoline.Complete(0)
+		for range 1_000_000 {
+			tr = trampoline.FlatMap(tr, func(i int) trampoline.Trampoline[int] {
+				return trampoline.Complete(i + 1)
+			})
+		}
+
+		res := tr.Execute()
+
+		assert.Equal(t, 1_000_000, res)
+	})
+
+	t.Run(`mutual recursion of different types`, func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, isEven(0).Execute())
+		assert.False(t, isEven(1).Execute())
+		assert.Equal(t, "even", parity(100_000).Execute())
+		assert.Equal(t, "odd", parity(100_001).Execute())
+	})
+
+	t.Run(`nil interface result`, func(t *testing.T) {
+		t.Parallel()
+
+		res := trampoline.Map(trampoline.Complete(1), func(_ int) error { return nil }).Execute()
+
+		assert.NoError(t, res)
+	})
+}
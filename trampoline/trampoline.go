@@ -22,14 +22,35 @@ SOFTWARE.
 
 package trampoline
 
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrStepLimitExceeded = errors.New("trampoline step limit exceeded")
+
 type TailCall[T any] func() Trampoline[T]
 
 type Trampoline[T any] interface {
 	Execute() T
+	// ExecuteContext - executes the trampoline until it completes or the context is done,
+	// returns the result, the number of executed steps and ctx.Err() if the execution was stopped.
+	ExecuteContext(ctx context.Context) (T, int, error)
+	// ExecuteWithLimit - executes the trampoline until it completes or maxSteps steps are executed,
+	// returns the result, the number of executed steps and ErrStepLimitExceeded if the execution was stopped.
+	ExecuteWithLimit(maxSteps int) (T, int, error)
 
-	next() TailCall[T]
-	done() bool
-	result() T
+	step() step
+}
+
+// step - type erased trampoline step, which lets Execute interpret chains of trampolines of different types.
+type step struct {
+	finished bool
+	value    any
+	nextCall func() step
+	source   func() step
+	cont     func(value any) step
 }
 
 type trampolineImpl[T any] struct {
@@ -39,27 +60,55 @@ type trampolineImpl[T any] struct {
 }
 
 //nolint:unused // it's actually used by the Execute function.
-func (tr trampolineImpl[T]) next() TailCall[T] {
-	return tr.nextCall
+func (tr trampolineImpl[T]) step() step {
+	if tr.finished {
+		return step{finished: true, value: tr.value}
+	}
+
+	return step{
+		nextCall: func() step {
+			return tr.nextCall().step()
+		},
+	}
 }
 
-//nolint:unused // it's actually used by the Execute function.
-func (tr trampolineImpl[T]) done() bool {
-	return tr.finished
+func (tr trampolineImpl[T]) Execute() T {
+	return execute[T](tr)
 }
 
-//nolint:unused // it's actually used by the Execute function.
-func (tr trampolineImpl[T]) result() T {
-	return tr.value
+func (tr trampolineImpl[T]) ExecuteContext(ctx context.Context) (T, int, error) {
+	return executeContext[T](ctx, tr)
 }
 
-func (tr trampolineImpl[T]) Execute() T {
-	var trmp Trampoline[T] = tr
-	for !trmp.done() {
-		trmp = trmp.next()()
+func (tr trampolineImpl[T]) ExecuteWithLimit(maxSteps int) (T, int, error) {
+	return executeWithLimit[T](tr, maxSteps)
+}
+
+type flatMapImpl[T, U any] struct {
+	source Trampoline[T]
+	fn     func(T) Trampoline[U]
+}
+
+//nolint:unused // it's actually used by the Execute function.
+func (tr flatMapImpl[T, U]) step() step {
+	return step{
+		source: tr.source.step,
+		cont: func(value any) step {
+			return tr.fn(valueOf[T](value)).step()
+		},
 	}
+}
 
-	return trmp.result()
+func (tr flatMapImpl[T, U]) Execute() U {
+	return execute[U](tr)
+}
+
+func (tr flatMapImpl[T, U]) ExecuteContext(ctx context.Context) (U, int, error) {
+	return executeContext[U](ctx, tr)
+}
+
+func (tr flatMapImpl[T, U]) ExecuteWithLimit(maxSteps int) (U, int, error) {
+	return executeWithLimit[U](tr, maxSteps)
 }
 
 func Complete[T any](result T) Trampoline[T] {
@@ -69,3 +118,113 @@ func Complete[T any](result T) Trampoline[T] {
 func Next[T any](nextCall TailCall[T]) Trampoline[T] {
 	return trampolineImpl[T]{nextCall: nextCall}
 }
+
+// FlatMap - chains the trampoline with the next one, computed from its result (a.k.a. Bind).
+// Allows expressing non-tail and mutual recursion, continuations are kept on the heap during the execution.
+func FlatMap[T, U any](tr Trampoline[T], fn func(T) Trampoline[U]) Trampoline[U] {
+	return flatMapImpl[T, U]{source: tr, fn: fn}
+}
+
+// Map - maps the trampoline result.
+func Map[T, U any](tr Trampoline[T], fn func(T) U) Trampoline[U] {
+	return FlatMap(tr, func(value T) Trampoline[U] {
+		return Complete(fn(value))
+	})
+}
+
+func execute[T any](tr Trampoline[T]) T {
+	//nolint:errcheck // execution without the check never fails
+	res, _, _ := run(tr, nil)
+
+	return res
+}
+
+func executeContext[T any](ctx context.Context, tr Trampoline[T]) (T, int, error) {
+	return run(tr, func(_ int) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err() //nolint:wrapcheck // context error is returned as is
+		default:
+			return nil
+		}
+	})
+}
+
+func executeWithLimit[T any](tr Trampoline[T], maxSteps int) (T, int, error) {
+	return run(tr, func(steps int) error {
+		if steps >= maxSteps {
+			return fmt.Errorf("%w: %d steps", ErrStepLimitExceeded, maxSteps)
+		}
+
+		return nil
+	})
+}
+
+// run - executes the trampoline, check (if not nil) is invoked with the number of steps executed so far before each step.
+// Chains of Next and Complete are executed directly, the type erased steps are interpreted only once FlatMap is reached.
+func run[T any](tr Trampoline[T], check func(steps int) error) (T, int, error) {
+	for steps := 0; ; steps++ {
+		impl, ok := tr.(trampolineImpl[T])
+		if !ok {
+			return runSteps[T](tr.step(), steps, check)
+		}
+
+		if impl.finished {
+			return impl.value, steps, nil
+		}
+
+		if err := checkStep(check, steps); err != nil {
+			var zero T
+			return zero, steps, err
+		}
+
+		tr = impl.nextCall()
+	}
+}
+
+// runSteps - interprets the type erased steps, continuing from the given number of steps executed so far.
+func runSteps[T any](current step, steps int, check func(steps int) error) (T, int, error) {
+	conts := make([]func(value any) step, 0)
+	for ; ; steps++ {
+		if current.finished && len(conts) == 0 {
+			return valueOf[T](current.value), steps, nil
+		}
+
+		if err := checkStep(check, steps); err != nil {
+			var zero T
+			return zero, steps, err
+		}
+
+		switch {
+		case current.cont != nil:
+			conts = append(conts, current.cont)
+			current = current.source()
+		case !current.finished:
+			current = current.nextCall()
+		default:
+			cont := conts[len(conts)-1]
+			conts[len(conts)-1] = nil
+			conts = conts[:len(conts)-1]
+			current = cont(current.value)
+		}
+	}
+}
+
+func checkStep(check func(steps int) error, steps int) error {
+	if check == nil {
+		return nil
+	}
+
+	return check(steps)
+}
+
+// valueOf - converts type erased value back, nil is converted to the zero value (e.g. for nil interfaces).
+func valueOf[T any](value any) T {
+	typed, ok := value.(T)
+	if !ok {
+		var zero T
+		return zero
+	}
+
+	return typed
+}